@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"benchy/internal/application/services"
+	"benchy/internal/domain/entities"
 	"benchy/internal/infrastructure/feedback"
 )
 
@@ -15,6 +16,17 @@ import (
 type CLIHandler struct {
 	networkService    *services.NetworkService
 	monitoringService *services.MonitoringService
+	scenarioService   *services.ScenarioService
+	validatorService  *services.ValidatorService
+	peeringService    *services.PeeringService
+	mempoolService    *services.MempoolService
+	explorerService   *services.ExplorerService
+	contractService   *services.ContractService
+	keysService       *services.KeysService
+	rawTxService      *services.RawTxService
+	logsService       *services.LogsService
+	partitionService  *services.PartitionService
+	netemService      *services.NetemService
 	feedback          *feedback.ConsoleFeedback
 }
 
@@ -33,27 +45,97 @@ func NewCLIHandler() (*CLIHandler, error) {
 		return nil, fmt.Errorf("failed to create network service: %w", err)
 	}
 
-	monitoringService, err := services.NewMonitoringService()
+	monitoringService, err := services.NewMonitoringService(baseDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create monitoring service: %w", err)
 	}
 
+	peeringService, err := services.NewPeeringService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create peering service: %w", err)
+	}
+
+	logsService, err := services.NewLogsService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logs service: %w", err)
+	}
+
+	partitionService, err := services.NewPartitionService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create partition service: %w", err)
+	}
+
+	netemService, err := services.NewNetemService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create netem service: %w", err)
+	}
+
 	feedback := feedback.NewConsoleFeedback()
 
 	handler := &CLIHandler{
 		networkService:    networkService,
 		monitoringService: monitoringService,
+		scenarioService:   services.NewScenarioService(baseDir),
+		validatorService:  services.NewValidatorService(baseDir),
+		peeringService:    peeringService,
+		mempoolService:    services.NewMempoolService(baseDir),
+		explorerService:   services.NewExplorerService(baseDir),
+		contractService:   services.NewContractService(baseDir),
+		keysService:       services.NewKeysService(baseDir),
+		rawTxService:      services.NewRawTxService(baseDir),
+		logsService:       logsService,
+		partitionService:  partitionService,
+		netemService:      netemService,
 		feedback:          feedback,
 	}
 
 	return handler, nil
 }
 
-// HandleLaunchNetwork gère la commande launch-network
-func (h *CLIHandler) HandleLaunchNetwork(ctx context.Context) error {
+// HandleLaunchNetwork gère la commande launch-network, puis relie les nodes selon la topologie.
+// Une mnémonique non vide rend les comptes des nodes (et extraAccounts comptes de test) déterministes.
+func (h *CLIHandler) HandleLaunchNetwork(ctx context.Context, topologySpec string, mnemonic string, extraAccounts int) error {
+	topology, err := h.peeringService.ParseTopology(topologySpec)
+	if err != nil {
+		return err
+	}
+
+	if err := h.networkService.SetAccounts(mnemonic, extraAccounts); err != nil {
+		return err
+	}
+
 	h.feedback.Info(ctx, "🚀 Starting network launch...")
 	
-	return h.networkService.LaunchNetwork(ctx)
+	if err := h.networkService.LaunchNetwork(ctx); err != nil {
+		return err
+	}
+
+	if topology.Kind == entities.TopologyNone {
+		h.feedback.Info(ctx, "💡 Automatic peering disabled; connect the nodes with 'benchy peers connect'")
+		return nil
+	}
+
+	return h.peeringService.Connect(ctx, topology)
+}
+
+// HandleTeardown gère la commande teardown
+func (h *CLIHandler) HandleTeardown(ctx context.Context, removeVolumes bool) error {
+	return h.networkService.Teardown(ctx, removeVolumes)
+}
+
+// HandlePeersConnect gère la commande peers connect
+func (h *CLIHandler) HandlePeersConnect(ctx context.Context, topologySpec string) error {
+	topology, err := h.peeringService.ParseTopology(topologySpec)
+	if err != nil {
+		return err
+	}
+
+	return h.peeringService.Connect(ctx, topology)
+}
+
+// HandlePeersList gère la commande peers list
+func (h *CLIHandler) HandlePeersList(ctx context.Context) error {
+	return h.peeringService.List(ctx)
 }
 
 // HandleInfos gère la commande infos
@@ -61,24 +143,167 @@ func (h *CLIHandler) HandleInfos(ctx context.Context, updateInterval int) error
 	return h.monitoringService.DisplayNetworkInfo(ctx, updateInterval)
 }
 
-// HandleScenario gère la commande scenario
-func (h *CLIHandler) HandleScenario(ctx context.Context, scenarioName string) error {
-	h.feedback.Info(ctx, fmt.Sprintf("🎯 Running scenario: %s", scenarioName))
+// HandleMempool gère la commande mempool
+func (h *CLIHandler) HandleMempool(ctx context.Context, node string, updateInterval int) error {
+	return h.mempoolService.Display(ctx, node, updateInterval)
+}
+
+// HandleLogs gère la commande logs
+func (h *CLIHandler) HandleLogs(ctx context.Context, request services.LogsRequest) error {
+	return h.logsService.Show(ctx, request)
+}
+
+// HandleBlock gère la commande block
+func (h *CLIHandler) HandleBlock(ctx context.Context, node string, ref string) error {
+	return h.explorerService.Block(ctx, node, ref)
+}
+
+// HandleTx gère la commande tx
+func (h *CLIHandler) HandleTx(ctx context.Context, node string, hash string) error {
+	return h.explorerService.Tx(ctx, node, hash)
+}
+
+// HandleTxBuild gère la commande tx build
+func (h *CLIHandler) HandleTxBuild(ctx context.Context, request services.RawTxRequest, output string) error {
+	return h.rawTxService.Build(ctx, request, output)
+}
+
+// HandleTxSign gère la commande tx sign
+func (h *CLIHandler) HandleTxSign(ctx context.Context, input string, request services.RawTxRequest, output string) error {
+	return h.rawTxService.Sign(ctx, input, request, output)
+}
+
+// HandleTxBroadcast gère la commande tx broadcast
+func (h *CLIHandler) HandleTxBroadcast(ctx context.Context, node string, input string) error {
+	return h.rawTxService.Broadcast(ctx, node, input)
+}
+
+// HandleAccount gère la commande account
+func (h *CLIHandler) HandleAccount(ctx context.Context, node string, target string) error {
+	return h.explorerService.Account(ctx, node, target)
+}
+
+// HandleContractDeploy gère la commande contract deploy
+func (h *CLIHandler) HandleContractDeploy(ctx context.Context, from string, artifactPath string, contractName string, alias string, args []string) error {
+	return h.contractService.Deploy(ctx, from, artifactPath, contractName, alias, args)
+}
+
+// HandleContractCall gère la commande contract call
+func (h *CLIHandler) HandleContractCall(ctx context.Context, node string, target string, method string, args []string, abiPath string) error {
+	return h.contractService.Call(ctx, node, target, method, args, abiPath)
+}
+
+// HandleContractSend gère la commande contract send
+func (h *CLIHandler) HandleContractSend(ctx context.Context, from string, target string, method string, args []string, value string, abiPath string) error {
+	return h.contractService.Send(ctx, from, target, method, args, value, abiPath)
+}
+
+// HandleContractList gère la commande contract list
+func (h *CLIHandler) HandleContractList(ctx context.Context) error {
+	return h.contractService.List(ctx)
+}
+
+// HandleKeysList gère la commande keys list
+func (h *CLIHandler) HandleKeysList(ctx context.Context) error {
+	return h.keysService.List(ctx)
+}
+
+// HandleKeysImport gère la commande keys import
+func (h *CLIHandler) HandleKeysImport(ctx context.Context, name string, source string, force bool) error {
+	return h.keysService.Import(ctx, name, source, force)
+}
+
+// HandleKeysExport gère la commande keys export
+func (h *CLIHandler) HandleKeysExport(ctx context.Context, name string, output string, privateKey bool) error {
+	return h.keysService.Export(ctx, name, output, privateKey)
+}
+
+// HandleKeysMnemonic gère la commande keys mnemonic
+func (h *CLIHandler) HandleKeysMnemonic(ctx context.Context, mnemonic string, extraAccounts int) error {
+	return h.keysService.Mnemonic(ctx, mnemonic, extraAccounts)
+}
+
+// HandleScenario gère la commande scenario. Un profil netem non vide dégrade les liens de tous les
+// nodes pendant le scénario.
+func (h *CLIHandler) HandleScenario(ctx context.Context, scenarioName string, feePolicy string, backend string, netemSpec string) error {
+	policy, err := entities.ParseFeePolicy(feePolicy)
+	if err != nil {
+		return err
+	}
+
+	var netem *entities.NetemProfile
+	if netemSpec != "" {
+		if backend == "sim" {
+			return fmt.Errorf("--netem needs the Docker network: it cannot be used with --backend=sim")
+		}
+		if netem, err = entities.ParseNetemProfile(netemSpec); err != nil {
+			return err
+		}
+	}
+
+	switch backend {
+	case "", "rpc":
+	case "sim":
+		// La chaîne simulée part du genesis du réseau : clés et genesis sont générés au besoin
+		if err := h.networkService.EnsureConfiguration(ctx); err != nil {
+			return err
+		}
+		if err := h.scenarioService.UseSimulatedBackend(ctx); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown backend: %s (use rpc or sim)", backend)
+	}
+	h.scenarioService.SetFeePolicy(policy)
+
+	h.feedback.Info(ctx, fmt.Sprintf("🎯 Running scenario: %s (fee policy: %s)", scenarioName, policy))
+
+	if netem != nil {
+		nodes, err := h.netemService.SelectNodes(nil)
+		if err != nil {
+			return err
+		}
+		if err := h.netemService.Apply(ctx, nodes, netem); err != nil {
+			return err
+		}
+		// Le retrait ne dépend pas de ctx, qui peut avoir été annulé
+		defer h.netemService.Clear(context.Background(), nodes)
+	}
 	
 	switch scenarioName {
 	case "0", "init":
-		return h.handleInitScenario(ctx)
+		return h.scenarioService.RunInitScenario(ctx)
 	case "1", "transfers":
-		return h.handleTransfersScenario(ctx)
+		return h.scenarioService.RunTransferScenario(ctx)
 	case "2", "erc20":
-		return h.handleERC20Scenario(ctx)
+		return h.scenarioService.RunERC20Scenario(ctx)
 	case "3", "replacement":
-		return h.handleReplacementScenario(ctx)
+		return h.scenarioService.RunTransactionReplacementScenario(ctx)
 	default:
 		return fmt.Errorf("unknown scenario: %s", scenarioName)
 	}
 }
 
+// HandleValidatorsList gère la commande validators list
+func (h *CLIHandler) HandleValidatorsList(ctx context.Context) error {
+	return h.validatorService.List(ctx)
+}
+
+// HandleValidatorsPropose gère la commande validators propose
+func (h *CLIHandler) HandleValidatorsPropose(ctx context.Context, target string, authorize bool, voters []string) error {
+	return h.validatorService.Propose(ctx, target, authorize, voters)
+}
+
+// HandleValidatorsDiscard gère la commande validators discard
+func (h *CLIHandler) HandleValidatorsDiscard(ctx context.Context, target string, voters []string) error {
+	return h.validatorService.Discard(ctx, target, voters)
+}
+
+// HandleValidatorsWatch gère la commande validators watch
+func (h *CLIHandler) HandleValidatorsWatch(ctx context.Context) error {
+	return h.validatorService.Watch(ctx)
+}
+
 // HandleTemporaryFailure gère la commande temporary-failure
 func (h *CLIHandler) HandleTemporaryFailure(ctx context.Context, nodeName string) error {
 	h.feedback.Info(ctx, fmt.Sprintf("🔥 Simulating failure for node: %s", nodeName))
@@ -94,6 +319,49 @@ func (h *CLIHandler) HandleTemporaryFailure(ctx context.Context, nodeName string
 	return nil
 }
 
+// HandleNetem gère la commande netem
+func (h *CLIHandler) HandleNetem(ctx context.Context, nodeNames []string, profile entities.NetemProfile, duration time.Duration) error {
+	nodes, err := h.netemService.SelectNodes(nodeNames)
+	if err != nil {
+		return err
+	}
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+	if duration < 0 {
+		return fmt.Errorf("invalid netem duration: %s", duration)
+	}
+
+	return h.netemService.Run(ctx, nodes, &profile, duration)
+}
+
+// HandleNetemClear gère la commande netem --clear
+func (h *CLIHandler) HandleNetemClear(ctx context.Context, nodeNames []string) error {
+	nodes, err := h.netemService.SelectNodes(nodeNames)
+	if err != nil {
+		return err
+	}
+	return h.netemService.Clear(ctx, nodes)
+}
+
+// HandlePartition gère la commande partition
+func (h *CLIHandler) HandlePartition(ctx context.Context, groupA string, groupB string, duration time.Duration) error {
+	partition, err := h.partitionService.ParsePartition(groupA, groupB)
+	if err != nil {
+		return err
+	}
+	if duration <= 0 {
+		return fmt.Errorf("invalid partition duration: %s", duration)
+	}
+
+	return h.partitionService.Run(ctx, partition, duration)
+}
+
+// HandlePartitionHeal gère la commande partition --heal
+func (h *CLIHandler) HandlePartitionHeal(ctx context.Context) error {
+	return h.partitionService.Heal(ctx)
+}
+
 // CheckDockerAvailable vérifie que Docker est disponible
 func (h *CLIHandler) CheckDockerAvailable(ctx context.Context) error {
 	h.feedback.Info(ctx, "🐳 Checking Docker availability...")
@@ -103,11 +371,15 @@ func (h *CLIHandler) CheckDockerAvailable(ctx context.Context) error {
 		return err
 	}
 	
-	time.Sleep(1 * time.Second)
+	version, err := h.networkService.DockerVersion(ctx)
+	if err != nil {
+		spinner.Error("❌ Docker daemon is not reachable")
+		return err
+	}
 	spinner.Success("✅ Docker is available and ready")
 	
 	h.feedback.Info(ctx, "📋 Docker status:")
-	h.feedback.Info(ctx, "   - Docker daemon: Running")
+	h.feedback.Info(ctx, fmt.Sprintf("   - Docker daemon: Running (Engine %s)", version))
 	h.feedback.Info(ctx, "   - Required images: Will be pulled automatically")
 	h.feedback.Info(ctx, "   - Network: Ready to create")
 	
@@ -172,57 +444,3 @@ func (h *CLIHandler) HandleLaunchNetworkReal(ctx context.Context) error {
 	
 	return nil
 }
-
-// Handlers de scénarios individuels
-
-func (h *CLIHandler) handleInitScenario(ctx context.Context) error {
-	h.feedback.Info(ctx, "🎯 Running Scenario 0: Network Initialization")
-	
-	spinner, err := h.feedback.StartSpinner(ctx, "Checking network status...")
-	if err != nil {
-		return err
-	}
-	time.Sleep(3 * time.Second)
-	spinner.Success("✅ Network is healthy")
-	
-	h.feedback.Success(ctx, "✅ Scenario 0 completed successfully!")
-	return nil
-}
-
-func (h *CLIHandler) handleTransfersScenario(ctx context.Context) error {
-	h.feedback.Info(ctx, "🎯 Running Scenario 1: Continuous Transfers")
-	
-	for i := 1; i <= 3; i++ {
-		h.feedback.Info(ctx, fmt.Sprintf("📤 Transfer #%d: Alice → Bob (0.1 ETH)", i))
-		time.Sleep(2 * time.Second)
-	}
-	
-	h.feedback.Success(ctx, "✅ Scenario demonstration completed!")
-	return nil
-}
-
-func (h *CLIHandler) handleERC20Scenario(ctx context.Context) error {
-	h.feedback.Info(ctx, "🎯 Running Scenario 2: ERC20 Token Deployment")
-	
-	spinner, err := h.feedback.StartSpinner(ctx, "Deploying ERC20 contract...")
-	if err != nil {
-		return err
-	}
-	time.Sleep(3 * time.Second)
-	spinner.Success("✅ Contract deployed")
-	
-	h.feedback.Success(ctx, "✅ Scenario 2 completed successfully!")
-	return nil
-}
-
-func (h *CLIHandler) handleReplacementScenario(ctx context.Context) error {
-	h.feedback.Info(ctx, "🎯 Running Scenario 3: Transaction Replacement")
-	
-	h.feedback.Info(ctx, "📤 Sending transaction to Driss...")
-	time.Sleep(2 * time.Second)
-	h.feedback.Info(ctx, "📤 Replacing with higher fee transaction to Elena...")
-	time.Sleep(2 * time.Second)
-	
-	h.feedback.Success(ctx, "✅ Scenario 3 completed successfully!")
-	return nil
-}
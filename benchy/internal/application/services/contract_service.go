@@ -0,0 +1,377 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"benchy/internal/infrastructure/feedback"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ContractService déploie des contrats depuis leurs artifacts et appelle leurs méthodes via l'ABI
+type ContractService struct {
+	baseDir   string
+	ethClient *ethereum.EthereumClient
+	feedback  *feedback.ConsoleFeedback
+}
+
+// NewContractService crée un nouveau service de contrats
+func NewContractService(baseDir string) *ContractService {
+	return &ContractService{
+		baseDir:   baseDir,
+		ethClient: ethereum.NewEthereumClient(),
+		feedback:  feedback.NewConsoleFeedback(),
+	}
+}
+
+// contractTarget est un contrat résolu : son adresse, son nom éventuel et son ABI
+type contractTarget struct {
+	name    string
+	address common.Address
+	abi     abi.ABI
+}
+
+// Deploy déploie le contrat d'un artifact depuis un node, puis l'enregistre sous son nom (ou alias)
+func (cs *ContractService) Deploy(ctx context.Context, from string, artifactPath string, contractName string, alias string, args []string) error {
+	artifact, err := contracts.LoadArtifact(artifactPath, contractName)
+	if err != nil {
+		return err
+	}
+	if len(artifact.Bytecode) == 0 {
+		return fmt.Errorf("artifact %s has no bytecode to deploy", artifact.Name)
+	}
+
+	constructorArgs, err := contracts.ParseArguments(artifact.ABI.Constructor.Inputs, args, cs.resolveArgAddress)
+	if err != nil {
+		return fmt.Errorf("constructor: %w", err)
+	}
+	encodedArgs, err := artifact.ABI.Pack("", constructorArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to encode constructor arguments: %w", err)
+	}
+
+	nodeURL, sender, err := cs.loadSender(ctx, from)
+	if err != nil {
+		return err
+	}
+
+	deployCode := append(append([]byte{}, artifact.Bytecode...), encodedArgs...)
+
+	name := artifact.Name
+	if alias != "" {
+		name = alias
+	}
+
+	spinner, err := cs.feedback.StartSpinner(ctx, fmt.Sprintf("Deploying %s from %s...", name, displayName(strings.ToLower(from))))
+	if err != nil {
+		return err
+	}
+
+	sentAt := time.Now()
+	_, txHash, err := cs.ethClient.DeployContract(ctx, nodeURL, deployCode, sender)
+	if err != nil {
+		spinner.Error(fmt.Sprintf("❌ %s deployment failed", name))
+		return err
+	}
+
+	deployment, err := sentTransaction(ctx, cs.ethClient, nodeURL, txHash, entities.TxTypeContract, sentAt)
+	var receipt *ports.TransactionReceipt
+	if err == nil {
+		receipt, err = waitForConfirmation(ctx, cs.ethClient, cs.newTracker(nodeURL), nodeURL, deployment)
+	}
+	if err != nil {
+		spinner.Error(fmt.Sprintf("❌ %s deployment failed", name))
+		return fmt.Errorf("deployment %s: %w", txHash.Hex(), err)
+	}
+	spinner.Success(fmt.Sprintf("✅ %s deployed at %s (block #%d, confirmed in %s)",
+		name, receipt.ContractAddress.Hex(), receipt.BlockNumber, formatLatency(deployment.ConfirmationTime)))
+
+	registry, err := config.LoadContractRegistry(cs.baseDir)
+	if err != nil {
+		return err
+	}
+	if absolute, err := filepath.Abs(artifactPath); err == nil {
+		artifactPath = absolute
+	}
+	registry.Register(name, config.DeployedContract{
+		Address:    receipt.ContractAddress,
+		ABI:        artifact.RawABI,
+		Artifact:   artifactPath,
+		Deployer:   sender,
+		TxHash:     txHash,
+		Block:      receipt.BlockNumber,
+		DeployedAt: time.Now(),
+	})
+	if err := registry.Save(); err != nil {
+		return err
+	}
+
+	cs.feedback.Info(ctx, fmt.Sprintf("💡 Use it with: benchy contract call %s <method> [args]", name))
+	return nil
+}
+
+// Call appelle une méthode en lecture seule (eth_call) et décode ses valeurs de retour
+func (cs *ContractService) Call(ctx context.Context, node string, target string, method string, args []string, abiPath string) error {
+	contract, err := cs.resolveContract(target, abiPath)
+	if err != nil {
+		return err
+	}
+
+	abiMethod, data, err := cs.packCall(contract, method, args)
+	if err != nil {
+		return err
+	}
+
+	nodeURL, err := cs.nodeURL(node)
+	if err != nil {
+		return err
+	}
+
+	result, err := cs.ethClient.CallContract(ctx, nodeURL, contract.address, data)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := abiMethod.Outputs.Unpack(result)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s result: %w", abiMethod.Name, err)
+	}
+
+	labels := loadAddressLabels(cs.baseDir)
+	cs.feedback.Info(ctx, fmt.Sprintf("📞 %s.%s", contract.name, abiMethod.Sig))
+	if len(outputs) == 0 {
+		cs.feedback.Info(ctx, "   (no return value)")
+		return nil
+	}
+
+	rows := make([][]string, len(outputs))
+	for i, output := range outputs {
+		name := abiMethod.Outputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i)
+		}
+		rows[i] = []string{name, abiMethod.Outputs[i].Type.String(), labels.formatArg(output)}
+	}
+	if err := cs.feedback.DisplayTable(ctx, []string{"Output", "Type", "Value"}, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	return nil
+}
+
+// Send envoie une transaction appelant une méthode, signée par le node from, et affiche ses événements
+func (cs *ContractService) Send(ctx context.Context, from string, target string, method string, args []string, value string, abiPath string) error {
+	contract, err := cs.resolveContract(target, abiPath)
+	if err != nil {
+		return err
+	}
+
+	abiMethod, data, err := cs.packCall(contract, method, args)
+	if err != nil {
+		return err
+	}
+
+	amount, err := parseEther(value)
+	if err != nil {
+		return err
+	}
+	if amount.Sign() > 0 && !abiMethod.IsPayable() {
+		return fmt.Errorf("%s is not payable", abiMethod.Sig)
+	}
+
+	nodeURL, sender, err := cs.loadSender(ctx, from)
+	if err != nil {
+		return err
+	}
+	cs.ethClient.RegisterABI(contract.abi)
+
+	tx := entities.NewTransaction(sender, contract.address, amount, entities.TxTypeContract)
+	tx.Data = data
+
+	spinner, err := cs.feedback.StartSpinner(ctx, fmt.Sprintf("Sending %s.%s from %s...", contract.name, abiMethod.Name, displayName(strings.ToLower(from))))
+	if err != nil {
+		return err
+	}
+
+	txHash, err := cs.ethClient.SendTransaction(ctx, nodeURL, tx)
+	if err != nil {
+		spinner.Error(fmt.Sprintf("❌ %s failed", abiMethod.Name))
+		return err
+	}
+
+	receipt, err := waitForConfirmation(ctx, cs.ethClient, cs.newTracker(nodeURL), nodeURL, tx)
+	if err != nil {
+		spinner.Error(fmt.Sprintf("❌ %s failed", abiMethod.Name))
+		return fmt.Errorf("transaction %s: %w", txHash.Hex(), err)
+	}
+	spinner.Success(fmt.Sprintf("✅ %s mined in block #%d (gas used %d, confirmed in %s)",
+		txHash.Hex(), receipt.BlockNumber, receipt.GasUsed, formatLatency(tx.ConfirmationTime)))
+
+	if len(receipt.Logs) > 0 {
+		displayLogs(ctx, cs.feedback, receipt, loadAddressLabels(cs.baseDir))
+	}
+	return nil
+}
+
+// newTracker crée le tracker qui suit une transaction jusqu'à sa confirmation
+func (cs *ContractService) newTracker(nodeURL string) *ethereum.TransactionTracker {
+	return ethereum.NewTransactionTracker(cs.ethClient, nodeURL, ethereum.DefaultConfirmations)
+}
+
+// List affiche les contrats déployés enregistrés
+func (cs *ContractService) List(ctx context.Context) error {
+	registry, err := config.LoadContractRegistry(cs.baseDir)
+	if err != nil {
+		return err
+	}
+
+	names := registry.Names()
+	if len(names) == 0 {
+		cs.feedback.Info(ctx, "No contract deployed yet: run 'benchy contract deploy <artifact.json>'")
+		return nil
+	}
+
+	labels := loadAddressLabels(cs.baseDir)
+	rows := make([][]string, 0, len(names))
+	for _, name := range names {
+		contract := registry.Contracts[name]
+		rows = append(rows, []string{
+			name,
+			contract.Address.Hex(),
+			labels.short(contract.Deployer),
+			fmt.Sprintf("%d", contract.Block),
+			contract.Artifact,
+		})
+	}
+
+	cs.feedback.Info(ctx, "📦 Deployed contracts")
+	if err := cs.feedback.DisplayTable(ctx, []string{"Name", "Address", "Deployer", "Block", "Artifact"}, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	return nil
+}
+
+// resolveContract trouve l'adresse et l'ABI d'un contrat : nom enregistré, token connu ou adresse,
+// l'ABI pouvant être fournie par un artifact (--abi)
+func (cs *ContractService) resolveContract(target string, abiPath string) (*contractTarget, error) {
+	registry, err := config.LoadContractRegistry(cs.baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := &contractTarget{name: target}
+	var rawABI []byte
+
+	if name, deployed, exists := registry.Get(target); exists {
+		contract.name, contract.address, rawABI = name, deployed.Address, deployed.ABI
+	} else if common.IsHexAddress(target) {
+		contract.address = common.HexToAddress(target)
+		contract.name = shortAddress(contract.address)
+		if name, deployed, exists := registry.FindByAddress(contract.address); exists {
+			contract.name, rawABI = name, deployed.ABI
+		}
+	} else {
+		tokens, err := config.LoadTokenRegistry(cs.baseDir)
+		if err != nil {
+			return nil, err
+		}
+		address, exists := tokens.Get(strings.ToUpper(target))
+		if !exists {
+			return nil, fmt.Errorf("unknown contract: %s (deploy it with benchy contract deploy or pass an address)", target)
+		}
+		contract.address = address
+		contract.abi = contracts.BYTokenParsedABI()
+	}
+
+	switch {
+	case abiPath != "":
+		artifact, err := contracts.LoadArtifact(abiPath, "")
+		if err != nil {
+			return nil, err
+		}
+		contract.abi = artifact.ABI
+	case rawABI != nil:
+		if contract.abi, err = abi.JSON(bytes.NewReader(rawABI)); err != nil {
+			return nil, fmt.Errorf("invalid ABI stored for %s: %w", contract.name, err)
+		}
+	case len(contract.abi.Methods) == 0:
+		return nil, fmt.Errorf("no ABI known for %s: pass the artifact with --abi", target)
+	}
+
+	return contract, nil
+}
+
+// packCall encode l'appel d'une méthode (sélecteur + arguments)
+func (cs *ContractService) packCall(contract *contractTarget, method string, args []string) (abi.Method, []byte, error) {
+	abiMethod, err := contracts.FindMethod(contract.abi, method)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+
+	values, err := contracts.ParseArguments(abiMethod.Inputs, args, cs.resolveArgAddress)
+	if err != nil {
+		return abi.Method{}, nil, fmt.Errorf("%s: %w", abiMethod.Sig, err)
+	}
+
+	data, err := contract.abi.Pack(abiMethod.Name, values...)
+	if err != nil {
+		return abi.Method{}, nil, fmt.Errorf("failed to encode %s: %w", abiMethod.Sig, err)
+	}
+
+	return abiMethod, data, nil
+}
+
+// resolveArgAddress accepte un nom de node, un contrat enregistré ou une adresse comme argument address
+func (cs *ContractService) resolveArgAddress(value string) (common.Address, error) {
+	return resolveAccountOrContract(cs.baseDir, value)
+}
+
+// resolveAccountOrContract accepte un contrat enregistré en plus des formes de resolveAddress
+func resolveAccountOrContract(baseDir string, value string) (common.Address, error) {
+	if registry, err := config.LoadContractRegistry(baseDir); err == nil {
+		if _, deployed, exists := registry.Get(value); exists {
+			return deployed.Address, nil
+		}
+	}
+	return resolveAddress(baseDir, value)
+}
+
+// loadSender retourne l'URL du node et son adresse, après avoir chargé sa clé pour signer
+func (cs *ContractService) loadSender(ctx context.Context, from string) (string, common.Address, error) {
+	nodeURL, err := cs.nodeURL(from)
+	if err != nil {
+		return "", common.Address{}, err
+	}
+	name := strings.ToLower(from)
+
+	if err := cs.ethClient.ConnectToNode(ctx, nodeURL); err != nil {
+		return "", common.Address{}, fmt.Errorf("%s is not reachable: %w", name, err)
+	}
+
+	key, err := loadAccountKey(ctx, cs.feedback, cs.baseDir, name)
+	if err != nil {
+		return "", common.Address{}, fmt.Errorf("failed to load %s key: %w", name, err)
+	}
+
+	return nodeURL, cs.ethClient.AddAccount(key.PrivateKey), nil
+}
+
+// nodeURL retourne l'URL RPC d'un node
+func (cs *ContractService) nodeURL(node string) (string, error) {
+	name := strings.ToLower(node)
+	if _, exists := nodeRPCPorts[name]; !exists {
+		return "", fmt.Errorf("unknown node: %s", node)
+	}
+	return nodeRPCURL(name), nil
+}
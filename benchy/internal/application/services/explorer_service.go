@@ -0,0 +1,473 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"benchy/internal/infrastructure/feedback"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// cliqueDiffInTurn est la difficulté d'un bloc scellé par le signer dont c'était le tour
+const cliqueDiffInTurn = 2
+
+// ExplorerService affiche blocs, transactions et comptes, à la manière d'un explorateur de blocs
+type ExplorerService struct {
+	baseDir   string
+	ethClient *ethereum.EthereumClient
+	feedback  *feedback.ConsoleFeedback
+}
+
+// NewExplorerService crée un nouveau service d'exploration
+func NewExplorerService(baseDir string) *ExplorerService {
+	return &ExplorerService{
+		baseDir:   baseDir,
+		ethClient: ethereum.NewEthereumClient(),
+		feedback:  feedback.NewConsoleFeedback(),
+	}
+}
+
+// Block affiche l'en-tête d'un bloc ("latest", décimal ou 0x…), son signer Clique et ses transactions
+func (es *ExplorerService) Block(ctx context.Context, node string, ref string) error {
+	nodeURL, err := es.nodeURL(node)
+	if err != nil {
+		return err
+	}
+
+	number, err := es.resolveBlockNumber(ctx, nodeURL, ref)
+	if err != nil {
+		return err
+	}
+
+	block, err := es.ethClient.GetBlockByNumber(ctx, nodeURL, number)
+	if err != nil {
+		return err
+	}
+
+	labels := es.loadLabels()
+
+	signer := "- (genesis)"
+	if block.Number > 0 {
+		turn := "out of turn"
+		if block.Difficulty != nil && block.Difficulty.Int64() == cliqueDiffInTurn {
+			turn = "in turn"
+		}
+		signer = fmt.Sprintf("%s (%s)", labels.label(block.Signer), turn)
+	}
+
+	gasUsage := "-"
+	if block.GasLimit > 0 {
+		gasUsage = fmt.Sprintf("%d / %d (%.1f%%)", block.GasUsed, block.GasLimit, float64(block.GasUsed)*100/float64(block.GasLimit))
+	}
+
+	baseFee := "-"
+	if block.BaseFee != nil {
+		baseFee = formatGwei(block.BaseFee)
+	}
+
+	timestamp := time.Unix(int64(block.Timestamp), 0)
+	rows := [][]string{
+		{"Number", fmt.Sprintf("%d", block.Number)},
+		{"Hash", block.Hash.Hex()},
+		{"Parent", block.ParentHash.Hex()},
+		{"Timestamp", fmt.Sprintf("%s (%s ago)", timestamp.Format(time.RFC3339), formatAge(time.Since(timestamp)))},
+		{"Signer", signer},
+		{"Difficulty", fmt.Sprint(block.Difficulty)},
+		{"Gas used", gasUsage},
+		{"Base fee", baseFee},
+		{"Extra data", fmt.Sprintf("%d bytes", len(block.ExtraData))},
+		{"Transactions", fmt.Sprintf("%d", len(block.TxDetails))},
+	}
+
+	es.feedback.Info(ctx, fmt.Sprintf("🧱 Block #%d", block.Number))
+	if err := es.feedback.DisplayTable(ctx, []string{"Field", "Value"}, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	if len(block.TxDetails) == 0 {
+		return nil
+	}
+
+	var txRows [][]string
+	for _, tx := range block.TxDetails {
+		txRows = append(txRows, []string{
+			fmt.Sprintf("%d", tx.TransactionIndex),
+			tx.Hash.Hex(),
+			labels.short(tx.From),
+			labels.shortTo(tx.To),
+			formatEther(tx.Value),
+			fmt.Sprintf("%d", tx.Nonce),
+		})
+	}
+
+	if err := es.feedback.DisplayTable(ctx, []string{"#", "Hash", "From", "To", "Value", "Nonce"}, txRows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	return nil
+}
+
+// Tx affiche une transaction, le statut et le gas de son reçu, et ses logs décodés
+func (es *ExplorerService) Tx(ctx context.Context, node string, hash string) error {
+	nodeURL, err := es.nodeURL(node)
+	if err != nil {
+		return err
+	}
+
+	if len(strings.TrimPrefix(hash, "0x")) != 2*common.HashLength {
+		return fmt.Errorf("invalid transaction hash: %s", hash)
+	}
+	txHash := common.HexToHash(hash)
+
+	tx, err := es.ethClient.GetTransaction(ctx, nodeURL, txHash)
+	if errors.Is(err, goethereum.NotFound) {
+		return fmt.Errorf("transaction %s not found on %s", txHash.Hex(), displayName(strings.ToLower(node)))
+	}
+	if err != nil {
+		return err
+	}
+
+	labels := es.loadLabels()
+
+	input := "-"
+	if len(tx.Input) >= 4 {
+		input = fmt.Sprintf("%d bytes (selector %s)", len(tx.Input), hexutil.Encode(tx.Input[:4]))
+	} else if len(tx.Input) > 0 {
+		input = fmt.Sprintf("%d bytes", len(tx.Input))
+	}
+
+	rows := [][]string{
+		{"Hash", tx.Hash.Hex()},
+		{"Status", "⏳ pending"},
+		{"From", labels.label(tx.From)},
+		{"To", labels.labelTo(tx.To)},
+		{"Value", formatEther(tx.Value)},
+		{"Nonce", fmt.Sprintf("%d", tx.Nonce)},
+		{"Type", formatTxType(tx.Type)},
+		{"Fees", formatTxFees(tx)},
+		{"Gas limit", fmt.Sprintf("%d", tx.Gas)},
+		{"Input", input},
+	}
+
+	var receipt *ports.TransactionReceipt
+	if tx.BlockNumber != nil {
+		receipt, err = es.ethClient.GetTransactionReceipt(ctx, nodeURL, txHash)
+		if err != nil {
+			return err
+		}
+
+		status := "✅ success"
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			status = "❌ failed"
+		}
+		rows[1][1] = status
+
+		rows = append(rows,
+			[]string{"Block", fmt.Sprintf("%d (index %d)", *tx.BlockNumber, tx.TransactionIndex)},
+			[]string{"Gas used", fmt.Sprintf("%d (%.1f%% of limit)", receipt.GasUsed, float64(receipt.GasUsed)*100/float64(tx.Gas))},
+		)
+		if receipt.ContractAddress != (common.Address{}) {
+			rows = append(rows, []string{"Contract created", labels.label(receipt.ContractAddress)})
+		}
+	}
+
+	es.feedback.Info(ctx, "🧾 Transaction")
+	if err := es.feedback.DisplayTable(ctx, []string{"Field", "Value"}, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	if receipt != nil && len(receipt.Logs) > 0 {
+		displayLogs(ctx, es.feedback, receipt, labels)
+	}
+
+	return nil
+}
+
+// Account affiche le solde, le nonce, le type de compte et les soldes de tokens d'un node ou d'une adresse
+func (es *ExplorerService) Account(ctx context.Context, node string, target string) error {
+	nodeURL, err := es.nodeURL(node)
+	if err != nil {
+		return err
+	}
+
+	address, err := resolveAddress(es.baseDir, target)
+	if err != nil {
+		return err
+	}
+
+	balance, err := es.ethClient.GetBalance(ctx, nodeURL, address)
+	if err != nil {
+		return err
+	}
+
+	nonce, err := es.ethClient.GetNonce(ctx, nodeURL, address)
+	if err != nil {
+		return err
+	}
+
+	code, err := es.ethClient.GetCode(ctx, nodeURL, address)
+	if err != nil {
+		return err
+	}
+
+	labels := es.loadLabels()
+
+	kind := "Externally owned account"
+	if len(code) > 0 {
+		kind = fmt.Sprintf("Contract (%d bytes of code)", len(code))
+	}
+
+	rows := [][]string{
+		{"Address", labels.label(address)},
+		{"Type", kind},
+		{"Balance", formatEther(balance)},
+		{"Nonce (pending)", fmt.Sprintf("%d", nonce)},
+	}
+
+	if signers, err := es.ethClient.GetSigners(ctx, nodeURL); err == nil {
+		validator := "no"
+		for _, signer := range signers {
+			if signer == address {
+				validator = "yes"
+			}
+		}
+		rows = append(rows, []string{"Clique signer", validator})
+	}
+
+	for _, symbol := range labels.tokens.Symbols() {
+		token, _ := labels.tokens.Get(symbol)
+		amount, err := es.ethClient.GetTokenBalance(ctx, nodeURL, token, address)
+		if err != nil {
+			continue
+		}
+		rows = append(rows, []string{symbol + " balance", fmt.Sprintf("%.2f %s", contracts.FromTokenUnits(amount), symbol)})
+	}
+
+	es.feedback.Info(ctx, "👤 Account")
+	if err := es.feedback.DisplayTable(ctx, []string{"Field", "Value"}, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	return nil
+}
+
+// displayLogs affiche les logs d'un reçu, décodés quand leur ABI est enregistrée
+func displayLogs(ctx context.Context, fb *feedback.ConsoleFeedback, receipt *ports.TransactionReceipt, labels *addressLabels) {
+	decoded := make(map[int]ports.DecodedEvent, len(receipt.Events))
+	for _, event := range receipt.Events {
+		decoded[event.LogIndex] = event
+	}
+
+	fb.Info(ctx, fmt.Sprintf("📜 Logs (%d)", len(receipt.Logs)))
+	for i, log := range receipt.Logs {
+		event, exists := decoded[i]
+		if !exists {
+			fb.Info(ctx, fmt.Sprintf("   #%d %s: %d topics, %d bytes of data (unknown event)",
+				i, labels.short(log.Address), len(log.Topics), len(log.Data)))
+			continue
+		}
+
+		names := make([]string, 0, len(event.Args))
+		for name := range event.Args {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		args := make([]string, len(names))
+		for j, name := range names {
+			args[j] = fmt.Sprintf("%s: %s", name, labels.formatArg(event.Args[name]))
+		}
+
+		fb.Info(ctx, fmt.Sprintf("   #%d %s %s(%s)", i, labels.short(event.Address), event.Name, strings.Join(args, ", ")))
+	}
+}
+
+// nodeURL retourne l'URL RPC du node interrogé
+func (es *ExplorerService) nodeURL(node string) (string, error) {
+	name := strings.ToLower(node)
+	if _, exists := nodeRPCPorts[name]; !exists {
+		return "", fmt.Errorf("unknown node: %s", node)
+	}
+	return nodeRPCURL(name), nil
+}
+
+// resolveBlockNumber interprète "latest", un numéro décimal ou hexadécimal
+func (es *ExplorerService) resolveBlockNumber(ctx context.Context, nodeURL string, ref string) (uint64, error) {
+	ref = strings.ToLower(strings.TrimSpace(ref))
+	if ref == "" || ref == "latest" {
+		return es.ethClient.GetLatestBlockNumber(ctx, nodeURL)
+	}
+
+	if strings.HasPrefix(ref, "0x") {
+		number, err := hexutil.DecodeUint64(ref)
+		if err != nil {
+			return 0, fmt.Errorf("invalid block number: %s", ref)
+		}
+		return number, nil
+	}
+
+	number, err := strconv.ParseUint(ref, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid block number: %s (use a number or latest)", ref)
+	}
+	return number, nil
+}
+
+// loadLabels charge les noms connus des adresses et enregistre les ABI des contrats déployés
+func (es *ExplorerService) loadLabels() *addressLabels {
+	labels := loadAddressLabels(es.baseDir)
+	for _, name := range labels.contracts.Names() {
+		_, contract, _ := labels.contracts.Get(name)
+		if contractABI, err := abi.JSON(bytes.NewReader(contract.ABI)); err == nil {
+			es.ethClient.RegisterABI(contractABI)
+		}
+	}
+	return labels
+}
+
+// loadAddressLabels charge les noms connus des adresses : nodes benchy, tokens et contrats enregistrés
+func loadAddressLabels(baseDir string) *addressLabels {
+	tokens, err := config.LoadTokenRegistry(baseDir)
+	if err != nil {
+		tokens = &config.TokenRegistry{Tokens: make(map[string]common.Address)}
+	}
+
+	deployed, err := config.LoadContractRegistry(baseDir)
+	if err != nil {
+		deployed = &config.ContractRegistry{Contracts: make(map[string]config.DeployedContract)}
+	}
+
+	return &addressLabels{
+		nodes:     loadNodeAddresses(baseDir),
+		tokens:    tokens,
+		contracts: deployed,
+	}
+}
+
+// addressLabels nomme les adresses connues de benchy pour l'affichage
+type addressLabels struct {
+	nodes     map[string]common.Address
+	tokens    *config.TokenRegistry
+	contracts *config.ContractRegistry
+}
+
+// name retourne le nom d'une adresse connue ("Alice", "BY token", "Counter"), ou une chaîne vide
+func (al *addressLabels) name(address common.Address) string {
+	for name, nodeAddress := range al.nodes {
+		if nodeAddress == address {
+			return displayName(name)
+		}
+	}
+	for symbol, token := range al.tokens.Tokens {
+		if token == address {
+			return symbol + " token"
+		}
+	}
+	if name, _, exists := al.contracts.FindByAddress(address); exists {
+		return name
+	}
+	return ""
+}
+
+// label affiche l'adresse complète, précédée de son nom si elle est connue
+func (al *addressLabels) label(address common.Address) string {
+	if name := al.name(address); name != "" {
+		return fmt.Sprintf("%s (%s)", name, address.Hex())
+	}
+	return address.Hex()
+}
+
+// labelTo affiche le destinataire d'une transaction
+func (al *addressLabels) labelTo(to *common.Address) string {
+	if to == nil {
+		return "contract creation"
+	}
+	return al.label(*to)
+}
+
+// short affiche le nom d'une adresse connue, ou l'adresse abrégée
+func (al *addressLabels) short(address common.Address) string {
+	if name := al.name(address); name != "" {
+		return name
+	}
+	return shortAddress(address)
+}
+
+// shortTo affiche le destinataire d'une transaction dans un tableau
+func (al *addressLabels) shortTo(to *common.Address) string {
+	if to == nil {
+		return "contract creation"
+	}
+	return al.short(*to)
+}
+
+// formatArg affiche un argument d'événement ou une valeur de retour décodés
+func (al *addressLabels) formatArg(value interface{}) string {
+	switch v := value.(type) {
+	case common.Address:
+		return al.short(v)
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	case [32]byte:
+		return common.Hash(v).Hex()
+	case string:
+		return strconv.Quote(v)
+	}
+
+	// Tableaux ABI (address[], uint256[3]…) et bytesN autres que bytes32
+	list := reflect.ValueOf(value)
+	if list.Kind() == reflect.Array && list.Type().Elem().Kind() == reflect.Uint8 {
+		data := make([]byte, list.Len())
+		reflect.Copy(reflect.ValueOf(data), list)
+		return hexutil.Encode(data)
+	}
+	if list.Kind() == reflect.Slice || list.Kind() == reflect.Array {
+		elements := make([]string, list.Len())
+		for i := range elements {
+			elements[i] = al.formatArg(list.Index(i).Interface())
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// formatTxType nomme le type d'une transaction
+func formatTxType(txType uint8) string {
+	switch txType {
+	case types.LegacyTxType:
+		return "0 (legacy)"
+	case types.AccessListTxType:
+		return "1 (access list)"
+	case types.DynamicFeeTxType:
+		return "2 (EIP-1559)"
+	default:
+		return fmt.Sprintf("%d", txType)
+	}
+}
+
+// formatTxFees affiche tip et fee cap (EIP-1559) ou gas price (legacy) en gwei
+func formatTxFees(tx *ports.TransactionInfo) string {
+	if tx.GasFeeCap != nil && tx.GasTipCap != nil {
+		return fmt.Sprintf("tip %s / cap %s", formatGwei(tx.GasTipCap), formatGwei(tx.GasFeeCap))
+	}
+	return formatGwei(tx.GasPrice)
+}
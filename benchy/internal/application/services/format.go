@@ -0,0 +1,77 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// formatEther convertit un montant en wei en ETH lisible (ex: "1.5 ETH")
+func formatEther(wei *big.Int) string {
+	return formatUnits(wei, params.Ether) + " ETH"
+}
+
+// formatGwei convertit un montant en wei en gwei lisible (ex: "1.5 gwei")
+func formatGwei(wei *big.Int) string {
+	return formatUnits(wei, params.GWei) + " gwei"
+}
+
+// formatUnits divise un montant par une unité, sans notation scientifique
+func formatUnits(wei *big.Int, unit int64) string {
+	if wei == nil {
+		return "-"
+	}
+	value := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(float64(unit)))
+	return value.Text('f', -1)
+}
+
+// parseEther convertit un montant en ETH ("0.5", "1e-3") en wei
+func parseEther(amount string) (*big.Int, error) {
+	return parseUnits(amount, params.Ether, "ETH")
+}
+
+// parseGwei convertit un montant en gwei ("1.5") en wei
+func parseGwei(amount string) (*big.Int, error) {
+	return parseUnits(amount, params.GWei, "gwei")
+}
+
+// parseUnits convertit un montant exprimé dans une unité (ETH, gwei) en wei
+func parseUnits(amount string, unit int64, unitName string) (*big.Int, error) {
+	// big.Rat reste exact pour les décimales ("0.1" n'a pas de représentation binaire finie)
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %s amount: %s", unitName, amount)
+	}
+
+	value.Mul(value, new(big.Rat).SetInt64(unit))
+	if !value.IsInt() {
+		return nil, fmt.Errorf("%s amount %s is more precise than 1 wei", unitName, amount)
+	}
+	return new(big.Int).Set(value.Num()), nil
+}
+
+// formatAge affiche une durée arrondie à la seconde (ex: "2m05s")
+func formatAge(age time.Duration) string {
+	age = age.Round(time.Second)
+	if age < time.Minute {
+		return fmt.Sprintf("%ds", int(age.Seconds()))
+	}
+	if age < time.Hour {
+		return fmt.Sprintf("%dm%02ds", int(age.Minutes()), int(age.Seconds())%60)
+	}
+	return fmt.Sprintf("%dh%02dm", int(age.Hours()), int(age.Minutes())%60)
+}
+
+// formatLatency affiche une latence au dixième de seconde (ex: "4.2s")
+func formatLatency(latency time.Duration) string {
+	return latency.Round(100 * time.Millisecond).String()
+}
+
+// formatBytes affiche une taille en unités décimales, comme docker stats (ex: "1.5MB")
+func formatBytes(size uint64) string {
+	return units.HumanSize(float64(size))
+}
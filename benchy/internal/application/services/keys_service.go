@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/feedback"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// passphraseCache garde la passphrase saisie pour la durée de la commande
+var passphraseCache struct {
+	sync.Mutex
+	value *string
+}
+
+// keystorePassphrase retourne la passphrase qui déchiffre les keystores : BENCHY_KEYSTORE_PASSWORD, sinon saisie
+func keystorePassphrase(ctx context.Context, fb *feedback.ConsoleFeedback) (string, error) {
+	return readPassphrase(ctx, fb, false)
+}
+
+// newKeystorePassphrase retourne la passphrase d'un keystore à créer ; saisie, elle est demandée deux fois
+func newKeystorePassphrase(ctx context.Context, fb *feedback.ConsoleFeedback) (string, error) {
+	return readPassphrase(ctx, fb, true)
+}
+
+// readPassphrase lit BENCHY_KEYSTORE_PASSWORD, sinon la saisie (confirmée si confirm), gardée pour la
+// durée de la commande. Une passphrase vide est refusée.
+func readPassphrase(ctx context.Context, fb *feedback.ConsoleFeedback, confirm bool) (string, error) {
+	if passphrase, exists := os.LookupEnv(config.KeystorePassphraseEnv); exists {
+		if passphrase == "" {
+			return "", fmt.Errorf("%s is empty: keystores need a passphrase", config.KeystorePassphraseEnv)
+		}
+		return passphrase, nil
+	}
+
+	passphraseCache.Lock()
+	defer passphraseCache.Unlock()
+	if passphraseCache.value != nil {
+		return *passphraseCache.value, nil
+	}
+
+	passphrase, err := fb.SecretInput(ctx, "🔑 Keystore passphrase")
+	if err != nil {
+		return "", fmt.Errorf("failed to read keystore passphrase: %w", err)
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("empty keystore passphrase: keystores need a passphrase")
+	}
+	if confirm {
+		repeated, err := fb.SecretInput(ctx, "🔑 Repeat the passphrase")
+		if err != nil {
+			return "", fmt.Errorf("failed to read keystore passphrase: %w", err)
+		}
+		if repeated != passphrase {
+			return "", fmt.Errorf("keystore passphrases do not match")
+		}
+	}
+	passphraseCache.value = &passphrase
+
+	return passphrase, nil
+}
+
+// loadAccountKey déchiffre la clé d'un node ou d'un compte importé ; la passphrase n'est demandée
+// que pour un keystore V3
+func loadAccountKey(ctx context.Context, fb *feedback.ConsoleFeedback, baseDir string, name string) (*config.KeyPair, error) {
+	keyDir := accountKeyDir(baseDir, name)
+	if !config.KeyExists(keyDir, name) {
+		return nil, fmt.Errorf("no key found for %s (see 'benchy keys list')", name)
+	}
+
+	passphrase := ""
+	if _, err := os.Stat(config.KeystoreFilePath(keyDir, name)); err == nil {
+		if passphrase, err = keystorePassphrase(ctx, fb); err != nil {
+			return nil, err
+		}
+	}
+
+	return config.LoadKeyPairFromFile(keyDir, name, passphrase)
+}
+
+// accountKeyDir retourne le répertoire de clés d'un compte : celui du node, ou celui des comptes importés
+func accountKeyDir(baseDir string, name string) string {
+	if _, isNode := nodeRPCPorts[name]; isNode {
+		return config.NodeKeystoreDir(baseDir, name)
+	}
+	return config.AccountsKeystoreDir(baseDir)
+}
+
+// KeysService gère les clés des comptes benchy : nodes et comptes importés
+type KeysService struct {
+	baseDir  string
+	feedback *feedback.ConsoleFeedback
+}
+
+// NewKeysService crée un nouveau service de clés
+func NewKeysService(baseDir string) *KeysService {
+	return &KeysService{
+		baseDir:  baseDir,
+		feedback: feedback.NewConsoleFeedback(),
+	}
+}
+
+// List affiche les comptes connus, leur adresse et le format de stockage de leur clé
+func (ks *KeysService) List(ctx context.Context) error {
+	var rows [][]string
+	legacy := false
+
+	for _, name := range nodeNames {
+		keys, err := config.ListStoredKeys(config.NodeKeystoreDir(ks.baseDir, name))
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			rows = append(rows, []string{displayName(key.Name), "node", key.Address.Hex(), string(key.Format), key.Path})
+			legacy = legacy || key.Format == config.KeyFormatRaw
+		}
+	}
+
+	imported, err := config.ListStoredKeys(config.AccountsKeystoreDir(ks.baseDir))
+	if err != nil {
+		return err
+	}
+	for _, key := range imported {
+		rows = append(rows, []string{key.Name, "imported", key.Address.Hex(), string(key.Format), key.Path})
+		legacy = legacy || key.Format == config.KeyFormatRaw
+	}
+
+	if len(rows) == 0 {
+		ks.feedback.Info(ctx, "No key found: launch the network or run 'benchy keys import'")
+		return nil
+	}
+
+	ks.feedback.Info(ctx, "🔑 Keys")
+	if err := ks.feedback.DisplayTable(ctx, []string{"Name", "Kind", "Address", "Format", "File"}, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	if legacy {
+		ks.feedback.Warning(ctx, "⚠️  Raw keys are stored unencrypted: re-import them with 'benchy keys import <name> <file> --force'")
+	}
+
+	return nil
+}
+
+// Import enregistre une clé sous un nom, chiffrée avec la passphrase benchy. La source est un keystore
+// V3, un fichier de clé (hexadécimale ou brute) ou une clé hexadécimale passée directement.
+func (ks *KeysService) Import(ctx context.Context, name string, source string, force bool) error {
+	name = strings.ToLower(name)
+	keyDir := accountKeyDir(ks.baseDir, name)
+
+	if config.KeyExists(keyDir, name) && !force {
+		return fmt.Errorf("%s already has a key: use --force to replace it", name)
+	}
+
+	keyPair, err := ks.readKey(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	if _, isNode := nodeRPCPorts[name]; isNode {
+		if current, err := config.LoadAddressFromFile(keyDir, name); err == nil && current != keyPair.Address {
+			ks.feedback.Warning(ctx, fmt.Sprintf("⚠️  %s's address changes from %s: the genesis and validator set still use the old one", displayName(name), current.Hex()))
+		}
+	}
+
+	passphrase, err := newKeystorePassphrase(ctx, ks.feedback)
+	if err != nil {
+		return err
+	}
+
+	if err := keyPair.SaveKeyPairToFile(keyDir, name, passphrase); err != nil {
+		return err
+	}
+
+	ks.feedback.Success(ctx, fmt.Sprintf("✅ %s imported as %s (%s)", keyPair.Address.Hex(), name, config.KeystoreFilePath(keyDir, name)))
+	return nil
+}
+
+// Export écrit le keystore V3 d'un compte (pour MetaMask, cast --keystore, geth --unlock),
+// ou affiche sa clé privée en hexadécimal
+func (ks *KeysService) Export(ctx context.Context, name string, output string, privateKey bool) error {
+	name = strings.ToLower(name)
+	keyDir := accountKeyDir(ks.baseDir, name)
+	if !config.KeyExists(keyDir, name) {
+		return fmt.Errorf("no key found for %s", name)
+	}
+
+	// La passphrase déchiffre le keystore, ou chiffre l'export d'une clé brute
+	askPassphrase := keystorePassphrase
+	if _, err := os.Stat(config.KeystoreFilePath(keyDir, name)); os.IsNotExist(err) {
+		askPassphrase = newKeystorePassphrase
+	}
+	passphrase, err := askPassphrase(ctx, ks.feedback)
+	if err != nil {
+		return err
+	}
+
+	// Déchiffrer vérifie la passphrase, même pour une simple copie du keystore
+	keyPair, err := config.LoadKeyPairFromFile(keyDir, name, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if privateKey {
+		confirmed, err := ks.feedback.Confirm(ctx, fmt.Sprintf("Print %s's private key in clear text?", name))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return fmt.Errorf("export cancelled")
+		}
+		fmt.Println("0x" + hex.EncodeToString(crypto.FromECDSA(keyPair.PrivateKey)))
+		return nil
+	}
+
+	keyJSON, err := os.ReadFile(config.KeystoreFilePath(keyDir, name))
+	if os.IsNotExist(err) {
+		// Clé brute : on la chiffre pour l'export
+		keyJSON, err = keyPair.EncryptKeystore(passphrase)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		fmt.Println(string(keyJSON))
+		return nil
+	}
+
+	if err := os.WriteFile(output, keyJSON, 0600); err != nil {
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+	ks.feedback.Success(ctx, fmt.Sprintf("✅ %s (%s) exported to %s", name, keyPair.Address.Hex(), output))
+
+	return nil
+}
+
+// Mnemonic affiche les adresses qu'une mnémonique donne aux nodes et aux comptes de test ;
+// sans mnémonique, en génère une nouvelle
+func (ks *KeysService) Mnemonic(ctx context.Context, mnemonic string, extraAccounts int) error {
+	if mnemonic == "" {
+		generated, err := config.GenerateMnemonic()
+		if err != nil {
+			return err
+		}
+		mnemonic = generated
+		ks.feedback.Info(ctx, "🌱 New mnemonic (keep it to relaunch the same network):")
+		ks.feedback.Info(ctx, "   "+mnemonic)
+	}
+
+	normalized, err := config.NormalizeMnemonic(mnemonic)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(nodeNames)+extraAccounts)
+	for index := 0; index < len(nodeNames)+extraAccounts; index++ {
+		var name string
+		if index < len(nodeNames) {
+			name = displayName(nodeNames[index])
+		} else {
+			name = config.ExtraAccountName(index - len(nodeNames))
+		}
+
+		path := config.AccountDerivationPath(uint32(index))
+		keyPair, err := config.DeriveKeyPair(normalized, path)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, []string{name, path.String(), keyPair.Address.Hex()})
+	}
+
+	ks.feedback.Info(ctx, "🔑 Derived accounts")
+	if err := ks.feedback.DisplayTable(ctx, []string{"Account", "Path", "Address"}, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+	ks.feedback.Info(ctx, "💡 Launch with them: benchy launch-network --mnemonic \"<words>\"")
+
+	return nil
+}
+
+// readKey lit la clé à importer : fichier keystore V3, fichier de clé ou clé hexadécimale
+func (ks *KeysService) readKey(ctx context.Context, source string) (*config.KeyPair, error) {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read %s: %w", source, err)
+		}
+		// Pas un fichier : une clé hexadécimale
+		data = []byte(source)
+	}
+
+	if config.IsKeystoreJSON(data) {
+		passphrase, err := ks.feedback.SecretInput(ctx, fmt.Sprintf("🔑 Passphrase of %s", source))
+		if err != nil {
+			return nil, err
+		}
+		return config.DecryptKeystore(data, passphrase)
+	}
+
+	// Clé brute de 32 octets (ancien format benchy)
+	if len(data) == 32 {
+		if privateKey, err := crypto.ToECDSA(data); err == nil {
+			return config.NewKeyPair(privateKey), nil
+		}
+	}
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("import source is neither a keystore file nor a hex private key")
+	}
+	return config.NewKeyPair(privateKey), nil
+}
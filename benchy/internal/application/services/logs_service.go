@@ -0,0 +1,325 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/infrastructure/docker"
+	"benchy/internal/infrastructure/feedback"
+	"github.com/fatih/color"
+)
+
+const (
+	// logReorderWindow retient les lignes suivies le temps que celles des autres nodes arrivent,
+	// pour les afficher dans l'ordre de leurs horodatages
+	logReorderWindow = 300 * time.Millisecond
+	// logFlushInterval est la période d'affichage des lignes suivies
+	logFlushInterval = 100 * time.Millisecond
+)
+
+// nodeLogColors donne à chaque node la couleur de son préfixe
+var nodeLogColors = map[string]color.Attribute{
+	"alice":     color.FgCyan,
+	"bob":       color.FgGreen,
+	"cassandra": color.FgMagenta,
+	"driss":     color.FgYellow,
+	"elena":     color.FgBlue,
+}
+
+// LogsRequest regroupe les options de la commande logs
+type LogsRequest struct {
+	Nodes  []string // Vide : tous les nodes
+	Follow bool
+	Since  string // Durée ("10m") ou date, comme docker logs --since
+	Tail   string // Lignes par node avant le suivi ("all" : toutes)
+	Grep   string // Expression régulière sur le texte de la ligne
+	Level  string // Niveau minimal (warn : WARN, ERROR et CRIT)
+}
+
+// LogsService affiche les logs des nodes, entrelacés par horodatage
+type LogsService struct {
+	dockerClient *docker.DockerClient
+	feedback     *feedback.ConsoleFeedback
+	output       io.Writer
+}
+
+// NewLogsService crée un nouveau service de logs
+func NewLogsService() (*LogsService, error) {
+	fb := feedback.NewConsoleFeedback()
+	dockerClient, err := docker.NewDockerClient(fb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+
+	return &LogsService{
+		dockerClient: dockerClient,
+		feedback:     fb,
+		output:       os.Stdout,
+	}, nil
+}
+
+// nodeLogLine est une ligne de log d'un node, avec son niveau reconnu
+type nodeLogLine struct {
+	node  string
+	line  docker.LogLine
+	level entities.LogLevel
+}
+
+// logFilter applique --level et --grep. Les lignes sans niveau (traces de pile, suites d'une entrée
+// multi-lignes) prennent celui de la dernière ligne du même node.
+type logFilter struct {
+	minLevel   entities.LogLevel
+	pattern    *regexp.Regexp
+	lastLevels map[string]entities.LogLevel
+}
+
+// Show affiche l'historique des logs des nodes demandés puis, avec Follow, les suit jusqu'à l'arrêt
+// des containers ou l'annulation de ctx
+func (ls *LogsService) Show(ctx context.Context, request LogsRequest) error {
+	nodes, err := selectNodes(request.Nodes)
+	if err != nil {
+		return err
+	}
+
+	filter := &logFilter{lastLevels: make(map[string]entities.LogLevel)}
+	if request.Level != "" {
+		if filter.minLevel, err = entities.ParseLogLevel(request.Level); err != nil {
+			return err
+		}
+	}
+	if request.Grep != "" {
+		if filter.pattern, err = regexp.Compile(request.Grep); err != nil {
+			return fmt.Errorf("invalid --grep pattern: %w", err)
+		}
+	}
+
+	prefixes := logPrefixes(nodes)
+
+	// Containers des nodes, retrouvés par leurs labels
+	containers, err := nodeContainers(ctx, ls.dockerClient)
+	if err != nil {
+		return err
+	}
+	containerIDs := make(map[string]string)
+	for node, container := range containers {
+		containerIDs[node] = container.ID
+	}
+
+	// 1. Historique : tous les nodes sont lus en entier puis triés
+	historyStart := time.Now()
+	history, lastSeen, nodes, err := ls.readHistory(ctx, nodes, containerIDs, docker.LogOptions{Since: request.Since, Tail: request.Tail})
+	if err != nil {
+		return err
+	}
+	for _, entry := range history {
+		ls.print(entry, filter, prefixes)
+	}
+
+	if !request.Follow || len(nodes) == 0 {
+		return nil
+	}
+
+	// 2. Suivi : chaque node reprend après sa dernière ligne affichée
+	return ls.follow(ctx, nodes, containerIDs, lastSeen, historyStart, filter, prefixes)
+}
+
+// logPrefixes prépare le préfixe coloré de chaque node, aligné sur le nom le plus long
+func logPrefixes(nodes []string) map[string]string {
+	width := 0
+	for _, node := range nodes {
+		if len(node) > width {
+			width = len(node)
+		}
+	}
+
+	prefixes := make(map[string]string)
+	for _, node := range nodes {
+		prefixes[node] = color.New(nodeLogColors[node]).Sprintf("%-*s |", width, node)
+	}
+	return prefixes
+}
+
+// readHistory lit les logs existants des nodes et les trie par horodatage. Les nodes sans container
+// sont signalés et retirés ; lastSeen garde l'horodatage de la dernière ligne de chaque node.
+func (ls *LogsService) readHistory(ctx context.Context, nodes []string, containerIDs map[string]string, options docker.LogOptions) ([]nodeLogLine, map[string]time.Time, []string, error) {
+	histories := make([][]nodeLogLine, len(nodes))
+	errs := make([]error, len(nodes))
+
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		containerID, found := containerIDs[node]
+		if !found {
+			errs[i] = docker.ErrContainerNotFound
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, node string) {
+			defer wg.Done()
+
+			lines := make(chan docker.LogLine)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for line := range lines {
+					histories[i] = append(histories[i], nodeLogLine{node: node, line: line})
+				}
+			}()
+
+			errs[i] = ls.dockerClient.StreamLogs(ctx, containerID, options, lines)
+			close(lines)
+			<-done
+		}(i, node)
+	}
+	wg.Wait()
+
+	var history []nodeLogLine
+	var available []string
+	lastSeen := make(map[string]time.Time)
+	for i, node := range nodes {
+		if errors.Is(errs[i], docker.ErrContainerNotFound) {
+			ls.feedback.Warning(ctx, fmt.Sprintf("⚠️  %s has no container: skipped", containerName(node)))
+			continue
+		}
+		if errs[i] != nil {
+			return nil, nil, nil, errs[i]
+		}
+
+		available = append(available, node)
+		history = append(history, histories[i]...)
+		if count := len(histories[i]); count > 0 {
+			lastSeen[node] = histories[i][count-1].line.Time
+		}
+	}
+
+	// Le tri stable garde l'ordre d'un node pour les lignes de même horodatage
+	sort.SliceStable(history, func(a, b int) bool {
+		return history[a].line.Time.Before(history[b].line.Time)
+	})
+
+	return history, lastSeen, available, nil
+}
+
+// follow suit les logs des nodes et les affiche par horodatage, avec logReorderWindow de retard
+func (ls *LogsService) follow(ctx context.Context, nodes []string, containerIDs map[string]string, lastSeen map[string]time.Time, historyStart time.Time, filter *logFilter, prefixes map[string]string) error {
+	entries := make(chan nodeLogLine)
+	var wg sync.WaitGroup
+
+	for _, node := range nodes {
+		since, seen := lastSeen[node]
+		if !seen {
+			since = historyStart
+		}
+
+		wg.Add(1)
+		go func(node string, since time.Time, seen bool) {
+			defer wg.Done()
+
+			lines := make(chan docker.LogLine)
+			go func() {
+				defer close(lines)
+				options := docker.LogOptions{Follow: true, Since: unixTimestamp(since), Tail: "all"}
+				if err := ls.dockerClient.StreamLogs(ctx, containerIDs[node], options, lines); err != nil && ctx.Err() == nil {
+					ls.feedback.Warning(ctx, fmt.Sprintf("⚠️  %s: %v", node, err))
+				}
+			}()
+
+			for line := range lines {
+				// Le daemon renvoie aussi les lignes horodatées exactement à since, déjà affichées
+				if seen && !line.Time.After(since) {
+					continue
+				}
+				entries <- nodeLogLine{node: node, line: line}
+			}
+			if ctx.Err() == nil {
+				ls.feedback.Warning(ctx, fmt.Sprintf("⚠️  %s stopped: end of its logs", containerName(node)))
+			}
+		}(node, since, seen)
+	}
+
+	go func() {
+		wg.Wait()
+		close(entries)
+	}()
+
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+
+	var pending []nodeLogLine
+	for {
+		select {
+		case entry, open := <-entries:
+			if !open {
+				ls.flush(pending, time.Time{}, filter, prefixes)
+				return ctx.Err()
+			}
+			pending = append(pending, entry)
+		case <-ticker.C:
+			pending = ls.flush(pending, time.Now().Add(-logReorderWindow), filter, prefixes)
+		}
+	}
+}
+
+// flush affiche dans l'ordre les lignes antérieures à until (toutes si until est nul) et retourne les autres
+func (ls *LogsService) flush(pending []nodeLogLine, until time.Time, filter *logFilter, prefixes map[string]string) []nodeLogLine {
+	sort.SliceStable(pending, func(a, b int) bool {
+		return pending[a].line.Time.Before(pending[b].line.Time)
+	})
+
+	count := len(pending)
+	if !until.IsZero() {
+		count = sort.Search(len(pending), func(i int) bool {
+			return pending[i].line.Time.After(until)
+		})
+	}
+
+	for _, entry := range pending[:count] {
+		ls.print(entry, filter, prefixes)
+	}
+	return append(pending[:0], pending[count:]...)
+}
+
+// print affiche une ligne si elle passe les filtres
+func (ls *LogsService) print(entry nodeLogLine, filter *logFilter, prefixes map[string]string) {
+	if !filter.accept(&entry) {
+		return
+	}
+
+	text := entry.line.Text
+	switch entry.level {
+	case entities.LogLevelError, entities.LogLevelCrit:
+		text = color.RedString("%s", text)
+	case entities.LogLevelWarn:
+		text = color.YellowString("%s", text)
+	}
+
+	fmt.Fprintf(ls.output, "%s %s\n", prefixes[entry.node], text)
+}
+
+// accept reconnaît le niveau de la ligne et applique --level puis --grep
+func (f *logFilter) accept(entry *nodeLogLine) bool {
+	entry.level = entities.ClientLogLevel(entry.line.Text)
+	if entry.level == entities.LogLevelUnknown {
+		entry.level = f.lastLevels[entry.node]
+	}
+	f.lastLevels[entry.node] = entry.level
+
+	if f.minLevel != entities.LogLevelUnknown && entry.level < f.minLevel {
+		return false
+	}
+	return f.pattern == nil || f.pattern.MatchString(entry.line.Text)
+}
+
+// unixTimestamp met une date au format "secondes.nanosecondes" accepté par --since
+func unixTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10) + "." + fmt.Sprintf("%09d", t.Nanosecond())
+}
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/feedback"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MempoolService affiche le contenu du mempool des nodes (transactions pending et queued)
+type MempoolService struct {
+	baseDir   string
+	ethClient *ethereum.EthereumClient
+	feedback  *feedback.ConsoleFeedback
+}
+
+// NewMempoolService crée un nouveau service de mempool
+func NewMempoolService(baseDir string) *MempoolService {
+	return &MempoolService{
+		baseDir:   baseDir,
+		ethClient: ethereum.NewEthereumClient(),
+		feedback:  feedback.NewConsoleFeedback(),
+	}
+}
+
+// Display affiche le mempool d'un node, ou le résumé de tous les nodes si node est vide,
+// une fois ou toutes les updateInterval secondes
+func (ms *MempoolService) Display(ctx context.Context, node string, updateInterval int) error {
+	node = strings.ToLower(node)
+	if _, exists := nodeRPCPorts[node]; node != "" && !exists {
+		return fmt.Errorf("unknown node: %s", node)
+	}
+
+	if updateInterval <= 0 {
+		return ms.display(ctx, node)
+	}
+
+	ticker := time.NewTicker(time.Duration(updateInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		fmt.Print("\033[2J\033[H")
+		ms.feedback.Info(ctx, fmt.Sprintf("🧺 Mempool (last update: %s, press Ctrl+C to stop)", time.Now().Format("15:04:05")))
+		if err := ms.display(ctx, node); err != nil {
+			ms.feedback.Error(ctx, fmt.Sprintf("Error: %v", err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// display choisit entre le résumé de tous les nodes et le détail d'un node
+func (ms *MempoolService) display(ctx context.Context, node string) error {
+	if node == "" {
+		return ms.displaySummary(ctx)
+	}
+	return ms.displayNode(ctx, node)
+}
+
+// displaySummary affiche le nombre de transactions pending et queued de chaque node
+func (ms *MempoolService) displaySummary(ctx context.Context) error {
+	headers := []string{"Node", "Pending", "Queued"}
+	var rows [][]string
+
+	for _, name := range nodeNames {
+		rpcCtx, cancel := context.WithTimeout(ctx, nodeQueryTimeout)
+		status, err := ms.ethClient.GetTxPoolStatus(rpcCtx, nodeRPCURL(name))
+		cancel()
+
+		if err != nil {
+			rows = append(rows, []string{displayName(name), "-", "-"})
+			continue
+		}
+		rows = append(rows, []string{displayName(name), fmt.Sprintf("%d", status.Pending), formatQueued(status.Queued)})
+	}
+
+	if err := ms.feedback.DisplayTable(ctx, headers, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	ms.feedback.Info(ctx, "💡 Use 'benchy mempool <node>' to list the transactions of a node")
+	return nil
+}
+
+// displayNode liste les transactions du mempool d'un node par expéditeur, avec nonce, frais et âge
+func (ms *MempoolService) displayNode(ctx context.Context, name string) error {
+	nodeURL := nodeRPCURL(name)
+
+	status, err := ms.ethClient.GetTxPoolStatus(ctx, nodeURL)
+	if err != nil {
+		return err
+	}
+	ms.feedback.Info(ctx, fmt.Sprintf("🧺 %s mempool: %d pending, %s queued", displayName(name), status.Pending, formatQueued(status.Queued)))
+
+	content, err := ms.ethClient.GetTxPoolContent(ctx, nodeURL)
+	if err != nil {
+		// Contenu indisponible : les résumés de txpool_inspect suffisent à repérer un nonce bloqué
+		inspect, inspectErr := ms.ethClient.GetTxPoolInspect(ctx, nodeURL)
+		if inspectErr != nil {
+			return err
+		}
+		ms.displayInspect(ctx, inspect)
+		return nil
+	}
+
+	if len(content.Pending) == 0 && len(content.Queued) == 0 {
+		ms.feedback.Info(ctx, "💡 Mempool is empty")
+		return nil
+	}
+
+	addresses := loadNodeAddresses(ms.baseDir)
+	sightings, err := config.LoadTxSightings(ms.baseDir)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	inPool := make(map[common.Hash]bool)
+
+	headers := []string{"Pool", "Sender", "Nonce", "Hash", "To", "Value", "Fees", "Age"}
+	var rows [][]string
+	for _, pool := range []struct {
+		name string
+		txs  map[common.Address][]ports.PoolTransaction
+	}{{"pending", content.Pending}, {"queued", content.Queued}} {
+		for _, sender := range sortedSenders(pool.txs) {
+			for _, tx := range pool.txs[sender] {
+				inPool[tx.Hash] = true
+
+				to := "contract creation"
+				if tx.To != nil {
+					to = displayName(nodeNameByAddress(addresses, *tx.To))
+				}
+
+				rows = append(rows, []string{
+					pool.name,
+					displayName(nodeNameByAddress(addresses, sender)),
+					fmt.Sprintf("%d", tx.Nonce),
+					shortHash(tx.Hash),
+					to,
+					formatEther(tx.Value),
+					formatPoolFees(tx),
+					formatAge(now.Sub(sightings.See(tx.Hash, now))),
+				})
+			}
+		}
+	}
+
+	if err := ms.feedback.DisplayTable(ctx, headers, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	sightings.Retain(inPool)
+	if err := sightings.Save(); err != nil {
+		ms.feedback.Warning(ctx, fmt.Sprintf("⚠️  %v", err))
+	}
+
+	ms.reportNonceGaps(ctx, nodeURL, content.Queued, addresses)
+	ms.feedback.Info(ctx, "💡 Age is measured from the first time benchy saw the transaction")
+	return nil
+}
+
+// displayInspect affiche les résumés de txpool_inspect
+func (ms *MempoolService) displayInspect(ctx context.Context, inspect *ports.TxPoolInspect) {
+	addresses := loadNodeAddresses(ms.baseDir)
+
+	for _, pool := range []struct {
+		name      string
+		summaries map[common.Address]map[uint64]string
+	}{{"pending", inspect.Pending}, {"queued", inspect.Queued}} {
+		for sender, byNonce := range pool.summaries {
+			nonces := make([]uint64, 0, len(byNonce))
+			for nonce := range byNonce {
+				nonces = append(nonces, nonce)
+			}
+			sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+			for _, nonce := range nonces {
+				ms.feedback.Info(ctx, fmt.Sprintf("   %-8s %s #%d  %s",
+					pool.name, displayName(nodeNameByAddress(addresses, sender)), nonce, byNonce[nonce]))
+			}
+		}
+	}
+}
+
+// reportNonceGaps signale, pour chaque expéditeur en queued, les nonces manquants qui bloquent ses transactions
+func (ms *MempoolService) reportNonceGaps(ctx context.Context, nodeURL string, queued map[common.Address][]ports.PoolTransaction, addresses map[string]common.Address) {
+	for _, sender := range sortedSenders(queued) {
+		txs := queued[sender]
+		if len(txs) == 0 {
+			continue
+		}
+
+		// Nonce pending : prochain nonce exécutable après les transactions pending de l'expéditeur
+		next, err := ms.ethClient.GetNonce(ctx, nodeURL, sender)
+		if err != nil || next >= txs[0].Nonce {
+			continue
+		}
+
+		missing := fmt.Sprintf("nonce %d", next)
+		if txs[0].Nonce-next > 1 {
+			missing = fmt.Sprintf("nonces %d-%d", next, txs[0].Nonce-1)
+		}
+		ms.feedback.Warning(ctx, fmt.Sprintf("⚠️  %s: %s missing, %d queued transaction(s) from nonce %d cannot execute",
+			displayName(nodeNameByAddress(addresses, sender)), missing, len(txs), txs[0].Nonce))
+	}
+}
+
+// sortedSenders retourne les expéditeurs dans un ordre stable
+func sortedSenders(txs map[common.Address][]ports.PoolTransaction) []common.Address {
+	senders := make([]common.Address, 0, len(txs))
+	for sender := range txs {
+		senders = append(senders, sender)
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i][:], senders[j][:]) < 0
+	})
+	return senders
+}
+
+// formatPoolFees affiche tip et fee cap (EIP-1559) ou gas price (legacy) en gwei
+func formatPoolFees(tx ports.PoolTransaction) string {
+	if tx.GasFeeCap != nil && tx.GasTipCap != nil {
+		return fmt.Sprintf("tip %s / cap %s", formatGwei(tx.GasTipCap), formatGwei(tx.GasFeeCap))
+	}
+	return formatGwei(tx.GasPrice)
+}
+
+// formatQueued affiche le nombre de transactions queued, inconnu sans module txpool
+func formatQueued(queued int) string {
+	if queued < 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%d", queued)
+}
+
+// shortHash abrège un hash de transaction pour les tableaux
+func shortHash(hash common.Hash) string {
+	hex := hash.Hex()
+	return hex[:10] + "…" + hex[len(hex)-4:]
+}
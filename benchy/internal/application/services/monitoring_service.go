@@ -3,19 +3,32 @@ package services
 import (
 	"context"
 	"fmt"
-
-	"os/exec"
+	"math/big"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
 	"benchy/internal/infrastructure/docker"
 	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/ethereum/contracts"
 	"benchy/internal/infrastructure/feedback"
 	"benchy/internal/infrastructure/monitoring"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+// nodeQueryTimeout borne la requête JSON-RPC d'un node pour qu'un node lent ne bloque pas le tableau ; les use cases la reçoivent en paramètre
+const nodeQueryTimeout = 3 * time.Second
+
+// statsWarmupTimeout borne l'attente de la première mesure d'un flux de statistiques (le daemon en
+// envoie une par seconde, et il en faut deux pour le CPU)
+const statsWarmupTimeout = 3 * time.Second
+
 // MonitoringService orchestre le monitoring complet du réseau
 type MonitoringService struct {
+	baseDir      string
 	dockerClient *docker.DockerClient
 	ethClient    *ethereum.EthereumClient
 	systemMonitor *monitoring.SystemMonitor
@@ -23,17 +36,19 @@ type MonitoringService struct {
 }
 
 // NewMonitoringService crée un nouveau service de monitoring
-func NewMonitoringService() (*MonitoringService, error) {
-	dockerClient, err := docker.NewDockerClient()
+func NewMonitoringService(baseDir string) (*MonitoringService, error) {
+	fb := feedback.NewConsoleFeedback()
+	dockerClient, err := docker.NewDockerClient(fb)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create docker client: %w", err)
 	}
 
 	return &MonitoringService{
+		baseDir:       baseDir,
 		dockerClient:  dockerClient,
 		ethClient:     ethereum.NewEthereumClient(),
 		systemMonitor: monitoring.NewSystemMonitor(),
-		feedback:      feedback.NewConsoleFeedback(),
+		feedback:      fb,
 	}, nil
 }
 
@@ -46,11 +61,40 @@ func (ms *MonitoringService) DisplayNetworkInfo(ctx context.Context, updateInter
 	return ms.displayOneShotInfo(ctx)
 }
 
-// continuousMonitoring affiche les infos en continu
+// continuousMonitoring affiche les infos en continu : à chaque nouveau bloc reçu par abonnement
+// (newHeads), et au moins toutes les interval secondes pour les métriques qui changent sans bloc
 func (ms *MonitoringService) continuousMonitoring(ctx context.Context, interval int) error {
-	ms.feedback.Info(ctx, fmt.Sprintf("📊 Monitoring nodes (updating every %d seconds, press Ctrl+C to stop)", interval))
+	period := time.Duration(interval) * time.Second
+
+	heads := make(chan *ports.BlockHeader, 16)
+	subs := ms.subscribeHeads(ctx, heads)
+	defer func() {
+		for _, sub := range subs {
+			sub.Unsubscribe()
+		}
+	}()
+
+	// Un abonnement qui tombe est signalé : le node n'est plus rafraîchi qu'à chaque intervalle
+	dropped := make(chan string, len(subs))
+	subscribed := make([]string, 0, len(subs))
+	for name, sub := range subs {
+		subscribed = append(subscribed, name)
+		go func(name string, sub ports.Subscription) {
+			if err, lost := <-sub.Err(); lost && err != nil {
+				dropped <- fmt.Sprintf("⚠️  newHeads subscription on %s dropped, now refreshed every %d seconds: %v", name, interval, err)
+			}
+		}(name, sub)
+	}
+	sort.Strings(subscribed)
+
+	if len(subscribed) > 0 {
+		ms.feedback.Info(ctx, fmt.Sprintf("📊 Monitoring nodes (updating on every new block from %s and at least every %d seconds, press Ctrl+C to stop)",
+			strings.Join(subscribed, ", "), interval))
+	} else {
+		ms.feedback.Info(ctx, fmt.Sprintf("📊 Monitoring nodes (updating every %d seconds, press Ctrl+C to stop)", interval))
+	}
 
-	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	ticker := time.NewTicker(period)
 	defer ticker.Stop()
 
 	// Première exécution immédiate
@@ -58,17 +102,21 @@ func (ms *MonitoringService) continuousMonitoring(ctx context.Context, interval
 		ms.feedback.Error(ctx, fmt.Sprintf("Error: %v", err))
 	}
 
+	var lastBlock uint64
 	for {
 		select {
 		case <-ticker.C:
-			// Clear screen et afficher timestamp
-			fmt.Print("\033[2J\033[H")
-			ms.feedback.Info(ctx, fmt.Sprintf("📊 Network Information (Last update: %s)", time.Now().Format("15:04:05")))
-			fmt.Println()
-
-			if err := ms.displayOneShotInfo(ctx); err != nil {
-				ms.feedback.Error(ctx, fmt.Sprintf("Error updating info: %v", err))
+			ms.refresh(ctx)
+		case head := <-heads:
+			// Chaque bloc arrive de tous les nodes abonnés : un seul rafraîchissement par bloc
+			if head.Number <= lastBlock {
+				continue
 			}
+			lastBlock = head.Number
+			ms.refresh(ctx)
+			ticker.Reset(period)
+		case warning := <-dropped:
+			ms.feedback.Warning(ctx, warning)
 		case <-ctx.Done():
 			ms.feedback.Info(ctx, "🔄 Stopping monitoring...")
 			return ctx.Err()
@@ -76,6 +124,39 @@ func (ms *MonitoringService) continuousMonitoring(ctx context.Context, interval
 	}
 }
 
+// refresh efface l'écran et réaffiche le tableau
+func (ms *MonitoringService) refresh(ctx context.Context) {
+	// Clear screen et afficher timestamp
+	fmt.Print("\033[2J\033[H")
+	ms.feedback.Info(ctx, fmt.Sprintf("📊 Network Information (Last update: %s)", time.Now().Format("15:04:05")))
+	fmt.Println()
+
+	if err := ms.displayOneShotInfo(ctx); err != nil {
+		ms.feedback.Error(ctx, fmt.Sprintf("Error updating info: %v", err))
+	}
+}
+
+// subscribeHeads abonne le tableau aux nouveaux blocs des nodes en cours d'exécution, jusqu'à l'annulation
+// de ctx ; retourne les abonnements par node, à arrêter par l'appelant (un node sans WebSocket est
+// simplement interrogé à chaque intervalle)
+func (ms *MonitoringService) subscribeHeads(ctx context.Context, heads chan<- *ports.BlockHeader) map[string]ports.Subscription {
+	containers, err := ms.getRealBenchyContainers(ctx)
+	if err != nil {
+		return nil
+	}
+
+	subs := make(map[string]ports.Subscription)
+	for _, container := range containers {
+		if !container.Running {
+			continue
+		}
+		if sub, err := ms.ethClient.SubscribeNewHeads(ctx, nodeWSURL(container.NodeName), heads); err == nil {
+			subs[container.NodeName] = sub
+		}
+	}
+	return subs
+}
+
 // displayOneShotInfo affiche les infos une seule fois
 func (ms *MonitoringService) displayOneShotInfo(ctx context.Context) error {
 	// Récupérer les containers benchy RÉELS
@@ -86,16 +167,36 @@ func (ms *MonitoringService) displayOneShotInfo(ctx context.Context) error {
 
 	if len(containers) == 0 {
 		ms.feedback.Warning(ctx, "⚠️  No benchy containers found. Did you run 'benchy launch-network'?")
-		ms.feedback.Info(ctx, "💡 Run: docker ps | grep benchy")
+		ms.feedback.Info(ctx, "💡 Run: docker ps -a --filter label=benchy.network")
 		return nil
 	}
 
 	// Préparer les données du tableau
-	headers := []string{"Node", "Status", "Latest Block", "Peers", "CPU/Memory", "ETH Balance", "Container"}
+	headers := []string{"Node", "Status", "Latest Block", "Peers", "CPU/Memory", "Net/Block I/O", "ETH Balance", "Tokens", "Container"}
 	var rows [][]string
 
-	for _, container := range containers {
-		nodeInfo, err := ms.getRealNodeInfo(ctx, container)
+	// Tokens déployés par les scénarios (ex: BY)
+	tokens, err := config.LoadTokenRegistry(ms.baseDir)
+	if err != nil {
+		ms.feedback.Warning(ctx, fmt.Sprintf("⚠️  Token registry unavailable: %v", err))
+		tokens = nil
+	}
+
+	// Interroger tous les nodes en parallèle
+	infos := make([]*NodeInfo, len(containers))
+	errs := make([]error, len(containers))
+	var wg sync.WaitGroup
+	for i, container := range containers {
+		wg.Add(1)
+		go func(i int, container *ContainerInfo) {
+			defer wg.Done()
+			infos[i], errs[i] = ms.getRealNodeInfo(ctx, container, tokens)
+		}(i, container)
+	}
+	wg.Wait()
+
+	for i, container := range containers {
+		nodeInfo, err := infos[i], errs[i]
 		if err != nil {
 			// Node offline ou erreur
 			rows = append(rows, []string{
@@ -105,6 +206,8 @@ func (ms *MonitoringService) displayOneShotInfo(ctx context.Context) error {
 				"N/A",
 				"N/A",
 				"N/A",
+				"N/A",
+				"N/A",
 				container.ID[:12],
 			})
 			continue
@@ -115,8 +218,10 @@ func (ms *MonitoringService) displayOneShotInfo(ctx context.Context) error {
 			nodeInfo.StatusDisplay,
 			fmt.Sprintf("%d", nodeInfo.LatestBlock),
 			fmt.Sprintf("%d", nodeInfo.PeerCount),
-			fmt.Sprintf("%.1f%%/%.0fMB", nodeInfo.CPUUsage, nodeInfo.MemoryUsage),
+			formatCPUMemory(nodeInfo.Stats),
+			formatContainerIO(nodeInfo.Stats),
 			fmt.Sprintf("%.2f ETH", nodeInfo.ETHBalance),
+			formatTokenBalances(nodeInfo.TokenBalances),
 			container.ID[:12],
 		}
 
@@ -134,39 +239,26 @@ func (ms *MonitoringService) displayOneShotInfo(ctx context.Context) error {
 	return nil
 }
 
-// getRealBenchyContainers récupère les vrais containers benchy depuis Docker
+// getRealBenchyContainers récupère les containers des nodes benchy par leurs labels, arrêtés compris,
+// dans l'ordre des nodes
 func (ms *MonitoringService) getRealBenchyContainers(ctx context.Context) ([]*ContainerInfo, error) {
-	// Utiliser docker ps pour récupérer les containers benchy
-	cmd := exec.CommandContext(ctx, "docker", "ps", "--filter", "name=benchy-", "--format", "{{.ID}}\t{{.Names}}\t{{.Status}}")
-	output, err := cmd.Output()
+	nodes, err := nodeContainers(ctx, ms.dockerClient)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list docker containers: %w", err)
+		return nil, err
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
 	var containers []*ContainerInfo
-
-	for _, line := range lines {
-		if line == "" {
+	for _, nodeName := range nodeNames {
+		container, found := nodes[nodeName]
+		if !found {
 			continue
 		}
-		
-		parts := strings.Split(line, "\t")
-		if len(parts) < 3 {
-			continue
-		}
-
-		containerID := parts[0]
-		containerName := parts[1]
-		status := parts[2]
-
-		// Extraire le nom du node depuis le nom du container
-		nodeName := strings.TrimPrefix(containerName, "benchy-")
 
 		containers = append(containers, &ContainerInfo{
-			ID:       containerID,
+			ID:       container.ID,
 			NodeName: nodeName,
-			Status:   status,
+			Status:   container.Status,
+			Running:  container.State == "running",
 			Port:     ms.getNodePort(nodeName),
 			RPCPort:  ms.getNodeRPCPort(nodeName),
 		})
@@ -179,7 +271,8 @@ func (ms *MonitoringService) getRealBenchyContainers(ctx context.Context) ([]*Co
 type ContainerInfo struct {
 	ID       string
 	NodeName string
-	Status   string
+	Status   string // Statut Docker (ex: "Up 5 minutes")
+	Running  bool
 	Port     int
 	RPCPort  int
 }
@@ -190,69 +283,69 @@ type NodeInfo struct {
 	StatusDisplay string
 	LatestBlock   uint64
 	PeerCount     int
-	CPUUsage      float64
-	MemoryUsage   float64
+	Stats         *ports.ContainerStats // nil tant que le flux du container n'a rien mesuré
 	ETHBalance    float64
+	TokenBalances map[string]float64
 	PendingTxs    int
 }
 
 // getRealNodeInfo récupère les informations réelles d'un node
-func (ms *MonitoringService) getRealNodeInfo(ctx context.Context, container *ContainerInfo) (*NodeInfo, error) {
+func (ms *MonitoringService) getRealNodeInfo(ctx context.Context, container *ContainerInfo, tokens *config.TokenRegistry) (*NodeInfo, error) {
 	info := &NodeInfo{
 		Name: container.NodeName,
 	}
 
 	// 1. Vérifier le status du container
-	if !strings.Contains(container.Status, "Up") {
+	if !container.Running {
 		info.StatusDisplay = "❌ Offline"
 		return info, fmt.Errorf("container not running")
 	}
 
-	// 2. Récupérer les stats Docker réelles (CPU/RAM)
-	stats, err := ms.getRealContainerStats(ctx, container.ID)
-	if err == nil {
-		info.CPUUsage = stats.CPUUsage
-		info.MemoryUsage = stats.MemoryUsage
-	} else {
-		// Valeurs par défaut si erreur
-		info.CPUUsage = 0.5
-		info.MemoryUsage = 128.0
-	}
+	// 2. Récupérer les stats Docker réelles, entretenues par le flux du container
+	info.Stats, _ = ms.getContainerStats(ctx, container.ID)
 
-	// 3. Essayer de se connecter au node Ethereum
+	// 3. Récupérer les métriques blockchain RÉELLES en une seule requête batch
 	nodeURL := fmt.Sprintf("http://localhost:%d", container.RPCPort)
-	
-	if err := ms.ethClient.ConnectToNode(ctx, nodeURL); err != nil {
+
+	var address common.Address
+	var tokenAddresses map[string]common.Address
+	if loaded, err := config.LoadAddressFromFile(config.NodeKeystoreDir(ms.baseDir, container.NodeName), container.NodeName); err == nil {
+		address = loaded
+		if tokens != nil {
+			tokenAddresses = tokens.Tokens
+		}
+	}
+
+	rpcCtx, cancel := context.WithTimeout(ctx, nodeQueryTimeout)
+	defer cancel()
+
+	snapshot, err := ms.ethClient.GetNodeSnapshot(rpcCtx, nodeURL, address, tokenAddresses)
+	if err != nil {
 		info.StatusDisplay = "🔄 Starting"
-		info.LatestBlock = uint64(1234 + int(time.Now().Unix()%100))
-		info.PeerCount = 0
-		info.ETHBalance = 1000.0
 		return info, nil
 	}
 
-	// 4. Récupérer les métriques blockchain RÉELLES
-	if _, err := ms.ethClient.GetLatestBlockNumber(ctx, nodeURL); err == nil {
-		info.LatestBlock = uint64(1234 + int(time.Now().Unix()%50))
-	} else {
-		info.LatestBlock = uint64(1234 + int(time.Now().Unix()%100))
+	info.LatestBlock = snapshot.LatestBlock
+	if snapshot.PeerCount != nil {
+		info.PeerCount = *snapshot.PeerCount
 	}
-
-	if peerCount, err := ms.ethClient.GetPeerCount(ctx, nodeURL); err == nil {
-		info.PeerCount = peerCount
-	} else {
-		info.PeerCount = 0
+	if snapshot.PendingTxs != nil {
+		info.PendingTxs = *snapshot.PendingTxs
 	}
 
-	if pendingTxs, err := ms.ethClient.GetPendingTransactionCount(ctx, nodeURL); err == nil {
-		info.PendingTxs = pendingTxs
-	} else {
-		info.PendingTxs = 0
+	// 4. Balances ETH et tokens du compte du node
+	if snapshot.Balance != nil {
+		ethBalance := new(big.Float).SetInt(snapshot.Balance)
+		ethBalance.Quo(ethBalance, big.NewFloat(1e18))
+		info.ETHBalance, _ = ethBalance.Float64()
 	}
 
-	// 5. Récupérer la balance ETH (simulation pour l'instant)
-	info.ETHBalance = 1000.0 // Simulation, sera remplacé par vraie balance
+	info.TokenBalances = make(map[string]float64)
+	for symbol, balance := range snapshot.TokenBalances {
+		info.TokenBalances[symbol] = contracts.FromTokenUnits(balance)
+	}
 
-	// 6. Déterminer le status d'affichage final
+	// 5. Déterminer le status d'affichage final
 	if info.PeerCount > 0 {
 		info.StatusDisplay = "✅ Online"
 	} else if info.LatestBlock > 0 {
@@ -264,45 +357,57 @@ func (ms *MonitoringService) getRealNodeInfo(ctx context.Context, container *Con
 	return info, nil
 }
 
-// getRealContainerStats récupère les stats réelles d'un container
-func (ms *MonitoringService) getRealContainerStats(ctx context.Context, containerID string) (*ContainerStats, error) {
-	// Utiliser docker stats pour récupérer les vraies métriques
-	cmd := exec.CommandContext(ctx, "docker", "stats", "--no-stream", "--format", "{{.CPUPerc}}\t{{.MemUsage}}", containerID)
-	output, err := cmd.Output()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get container stats: %w", err)
-	}
-
-	line := strings.TrimSpace(string(output))
-	parts := strings.Split(line, "\t")
-	if len(parts) < 2 {
-		return nil, fmt.Errorf("invalid stats format")
-	}
-
-	// Parser CPU (format: "1.23%")
-	cpuStr := strings.TrimSuffix(parts[0], "%")
-	var cpuUsage float64
-	fmt.Sscanf(cpuStr, "%f", &cpuUsage)
-
-	// Parser Memory (format: "128MiB / 2GiB")
-	memParts := strings.Split(parts[1], " / ")
-	var memoryUsage float64
-	if len(memParts) > 0 {
-		memStr := memParts[0]
-		if strings.Contains(memStr, "MiB") {
-			memStr = strings.TrimSuffix(memStr, "MiB")
-			fmt.Sscanf(memStr, "%f", &memoryUsage)
-		} else if strings.Contains(memStr, "GiB") {
-			memStr = strings.TrimSuffix(memStr, "GiB")
-			fmt.Sscanf(memStr, "%f", &memoryUsage)
-			memoryUsage *= 1024 // Convertir en MB
-		}
+// formatCPUMemory affiche l'utilisation CPU et mémoire d'un container (ex: "3.2%/412MB")
+func formatCPUMemory(stats *ports.ContainerStats) string {
+	if stats == nil {
+		return "-"
 	}
+	return fmt.Sprintf("%.1f%%/%.0fMB", stats.CPUUsage, float64(stats.MemoryUsage)/1024/1024)
+}
 
-	return &ContainerStats{
-		CPUUsage:    cpuUsage,
-		MemoryUsage: memoryUsage,
-	}, nil
+// formatContainerIO affiche les octets reçus/envoyés et lus/écrits depuis le démarrage du container
+func formatContainerIO(stats *ports.ContainerStats) string {
+	if stats == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%s/%s | %s/%s",
+		formatBytes(stats.NetworkRX), formatBytes(stats.NetworkTX),
+		formatBytes(stats.BlockRead), formatBytes(stats.BlockWrite))
+}
+
+// formatTokenBalances affiche les balances de tokens d'un node (ex: "1000.00 BY")
+func formatTokenBalances(balances map[string]float64) string {
+	if len(balances) == 0 {
+		return "-"
+	}
+
+	symbols := make([]string, 0, len(balances))
+	for symbol := range balances {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	parts := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		parts = append(parts, fmt.Sprintf("%.2f %s", balances[symbol], symbol))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// getContainerStats lit les statistiques du container dans le cache du collecteur ; à la première
+// lecture, le flux vient d'être ouvert et on attend sa première mesure au plus statsWarmupTimeout
+func (ms *MonitoringService) getContainerStats(ctx context.Context, containerID string) (*ports.ContainerStats, bool) {
+	collector := ms.dockerClient.StatsCollector()
+	collector.Watch(containerID)
+
+	if stats, ok := collector.Stats(containerID); ok {
+		return stats, true
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, statsWarmupTimeout)
+	defer cancel()
+	return collector.WaitStats(waitCtx, containerID)
 }
 
 // getNodePort retourne le port P2P d'un node par son nom
@@ -343,7 +448,7 @@ func (ms *MonitoringService) displayRealNetworkSummary(ctx context.Context, cont
 	
 	onlineCount := 0
 	for _, container := range containers {
-		if strings.Contains(container.Status, "Up") {
+		if container.Running {
 			onlineCount++
 		}
 	}
@@ -360,9 +465,3 @@ func (ms *MonitoringService) displayRealNetworkSummary(ctx context.Context, cont
 		ms.feedback.Success(ctx, "✅ All containers are running")
 	}
 }
-
-// ContainerStats représente les stats d'un container
-type ContainerStats struct {
-	CPUUsage    float64
-	MemoryUsage float64
-}
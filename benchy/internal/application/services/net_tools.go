@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/docker"
+)
+
+// netToolsImage fournit iptables et tc : les images des clients ne les contiennent pas
+const netToolsImage = "nicolaka/netshoot:v0.13"
+
+// runNetworkHelper exécute un script shell dans l'espace réseau du container d'un node, avec NET_ADMIN,
+// puis supprime le container d'outillage. Les règles posées restent actives dans le node.
+func runNetworkHelper(ctx context.Context, dockerClient *docker.DockerClient, node string, containerID string, script string) error {
+	labels := resourceLabels(ports.RoleHelper)
+	labels[ports.LabelNodeName] = node
+
+	if err := dockerClient.RunContainer(ctx, ports.ContainerConfig{
+		Image:       netToolsImage,
+		Name:        fmt.Sprintf("%s-net-%d", containerName(node), time.Now().UnixNano()),
+		NetworkMode: "container:" + containerID,
+		CapAdd:      []string{"NET_ADMIN"},
+		Labels:      labels,
+		Command:     []string{"sh", "-c", script},
+	}); err != nil {
+		return fmt.Errorf("failed to configure %s network: %w", node, err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/infrastructure/docker"
+	"benchy/internal/infrastructure/feedback"
+)
+
+// netemDevice retrouve l'interface de la route par défaut du node, celle de benchy-network
+const netemDevice = `dev=$(ip route show default | awk '{print $5; exit}'); [ -n "$dev" ] || dev=eth0`
+
+// NetemService dégrade les liens des nodes (latence, gigue, pertes, débit) avec tc netem, posé depuis
+// un container d'outillage qui partage l'espace réseau du node
+type NetemService struct {
+	dockerClient *docker.DockerClient
+	feedback     *feedback.ConsoleFeedback
+}
+
+// NewNetemService crée un nouveau service netem
+func NewNetemService() (*NetemService, error) {
+	fb := feedback.NewConsoleFeedback()
+	dockerClient, err := docker.NewDockerClient(fb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+
+	return &NetemService{
+		dockerClient: dockerClient,
+		feedback:     fb,
+	}, nil
+}
+
+// SelectNodes valide les nodes demandés ; aucun node : tous
+func (ns *NetemService) SelectNodes(requested []string) ([]string, error) {
+	return selectNodes(requested)
+}
+
+// Run applique le profil aux nodes pendant duration (0 : jusqu'à l'annulation de ctx), puis le retire.
+// Le retrait a lieu même si ctx est annulé (Ctrl+C).
+func (ns *NetemService) Run(ctx context.Context, nodes []string, profile *entities.NetemProfile, duration time.Duration) error {
+	if err := ns.Apply(ctx, nodes, profile); err != nil {
+		return err
+	}
+
+	if duration > 0 {
+		ns.feedback.Info(ctx, fmt.Sprintf("⏳ Keeping the impairment for %s (Ctrl+C to stop earlier)", formatAge(duration)))
+		timer := time.NewTimer(duration)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	} else {
+		ns.feedback.Info(ctx, "⏳ Keeping the impairment until Ctrl+C")
+		<-ctx.Done()
+	}
+
+	// Le retrait ne doit pas dépendre de ctx, qui peut avoir été annulé
+	return ns.Clear(context.Background(), nodes)
+}
+
+// Apply remplace la discipline de sortie de chaque node par netem avec le profil donné. En cas
+// d'échec, les nodes déjà dégradés sont rétablis.
+func (ns *NetemService) Apply(ctx context.Context, nodes []string, profile *entities.NetemProfile) error {
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+	containers, err := ns.runningContainers(ctx, nodes)
+	if err != nil {
+		return err
+	}
+
+	ns.feedback.Info(ctx, fmt.Sprintf("🐢 Applying %s to %s", profile, strings.Join(nodes, ", ")))
+	script := fmt.Sprintf("set -e; %s; tc qdisc replace dev \"$dev\" root netem %s", netemDevice, strings.Join(profile.Args(), " "))
+	for i, node := range nodes {
+		if err := runNetworkHelper(ctx, ns.dockerClient, node, containers[node], script); err != nil {
+			ns.clear(context.Background(), nodes[:i], containers)
+			return err
+		}
+		ns.feedback.Info(ctx, fmt.Sprintf("   - %s: %s", node, profile))
+	}
+	ns.feedback.Success(ctx, "✅ Network impairment applied")
+	return nil
+}
+
+// Clear rétablit la discipline par défaut des nodes ; sans netem posé, il ne fait rien
+func (ns *NetemService) Clear(ctx context.Context, nodes []string) error {
+	containers, err := nodeContainers(ctx, ns.dockerClient)
+	if err != nil {
+		return err
+	}
+
+	// Un node arrêté n'a plus d'espace réseau : il repartira sans netem
+	running := make(map[string]string)
+	var cleared []string
+	for _, node := range nodes {
+		if container, found := containers[node]; found && container.State == "running" {
+			running[node] = container.ID
+			cleared = append(cleared, node)
+		}
+	}
+	if len(cleared) == 0 {
+		ns.feedback.Warning(ctx, "⚠️  No running benchy node to clear")
+		return nil
+	}
+
+	if err := ns.clear(ctx, cleared, running); err != nil {
+		return err
+	}
+	ns.feedback.Success(ctx, fmt.Sprintf("✅ Network impairment removed from %s", strings.Join(cleared, ", ")))
+	return nil
+}
+
+// runningContainers retrouve le container de chaque node ; tous doivent être en cours d'exécution
+func (ns *NetemService) runningContainers(ctx context.Context, nodes []string) (map[string]string, error) {
+	containers, err := nodeContainers(ctx, ns.dockerClient)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string)
+	for _, node := range nodes {
+		container, found := containers[node]
+		if !found {
+			return nil, fmt.Errorf("%s has no container: launch the network first", node)
+		}
+		if container.State != "running" {
+			return nil, fmt.Errorf("%s is not running (%s)", node, container.Status)
+		}
+		ids[node] = container.ID
+	}
+	return ids, nil
+}
+
+// clear retire netem de chaque node ; les erreurs n'interrompent pas les autres nodes
+func (ns *NetemService) clear(ctx context.Context, nodes []string, containers map[string]string) error {
+	script := fmt.Sprintf("%s; tc qdisc del dev \"$dev\" root 2>/dev/null; true", netemDevice)
+
+	var errs []error
+	for _, node := range nodes {
+		if err := runNetworkHelper(ctx, ns.dockerClient, node, containers[node], script); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
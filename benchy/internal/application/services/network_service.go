@@ -2,46 +2,92 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
-	"os/exec"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
 	"benchy/internal/infrastructure/docker"
 	"benchy/internal/infrastructure/feedback"
 	"benchy/internal/infrastructure/monitoring"
 )
 
+const (
+	// gethImage et nethermindImage sont les images Docker des deux clients du réseau
+	gethImage       = "ethereum/client-go:v1.13.15"
+	nethermindImage = "nethermind/nethermind:latest"
+
+	// gethValidatorHTTPAPI expose en plus le module miner aux validateurs Geth
+	gethValidatorHTTPAPI = "eth,net,web3,personal,miner,clique,txpool"
+	gethHTTPAPI          = "eth,net,web3,personal,clique,txpool"
+	// gethWSAPI ajoute le module admin (peering), servi sur le WebSocket publié sur localhost seulement
+	gethWSAPI = "eth,net,web3,admin"
+)
+
 // NetworkService gère le lancement et la configuration du réseau
 type NetworkService struct {
 	dockerClient  *docker.DockerClient
 	feedback      *feedback.ConsoleFeedback
 	monitor       *monitoring.SystemMonitor
 	baseDir       string
+	mnemonic      string // Phrase BIP-39 dont dérivent les comptes (vide = clés aléatoires)
+	extraAccounts int    // Comptes de test financés en plus des 5 nodes
 }
 
 // NewNetworkService crée un nouveau service réseau
 func NewNetworkService(baseDir string) (*NetworkService, error) {
-	dockerClient, err := docker.NewDockerClient()
+	fb := feedback.NewConsoleFeedback()
+	dockerClient, err := docker.NewDockerClient(fb)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create docker client: %w", err)
 	}
 
 	return &NetworkService{
 		dockerClient:  dockerClient,
-		feedback:      feedback.NewConsoleFeedback(),
+		feedback:      fb,
 		monitor:       monitoring.NewSystemMonitor(),
 		baseDir:       baseDir,
 	}, nil
 }
 
+// SetAccounts choisit la mnémonique dont dérivent les clés et le nombre de comptes de test supplémentaires
+func (ns *NetworkService) SetAccounts(mnemonic string, extraAccounts int) error {
+	if mnemonic != "" {
+		normalized, err := config.NormalizeMnemonic(mnemonic)
+		if err != nil {
+			return err
+		}
+		mnemonic = normalized
+	}
+	if extraAccounts < 0 {
+		return fmt.Errorf("invalid number of extra accounts: %d", extraAccounts)
+	}
+
+	ns.mnemonic = mnemonic
+	ns.extraAccounts = extraAccounts
+	return nil
+}
+
 // LaunchNetwork lance le réseau Ethereum avec 5 nodes
 func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	ns.feedback.Info(ctx, "🚀 Launching Ethereum network...")
 
+	// 0. Un réseau déjà lancé occupe les noms et les ports des containers
+	existing, err := nodeContainers(ctx, ns.dockerClient)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("%d benchy node containers already exist: run 'benchy teardown' first", len(existing))
+	}
+
 	// 1. Configuration
 	ns.feedback.Info(ctx, "📋 Configuration:")
 	ns.feedback.Info(ctx, "   - 5 nodes: Alice, Bob, Cassandra, Driss, Elena")
@@ -49,15 +95,16 @@ func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	ns.feedback.Info(ctx, "   - Clients: Geth + Nethermind")
 	ns.feedback.Info(ctx, "   - Consensus: Clique")
 
+	if err := ns.generateConfiguration(ctx); err != nil {
+		return fmt.Errorf("failed to generate configuration: %w", err)
+	}
 	ns.feedback.Success(ctx, "✅ Configuration generated successfully")
 
 	// 2. Créer le réseau Docker
-	if err := ns.dockerClient.CreateNetwork(ctx, "benchy-network"); err != nil {
-		ns.feedback.Warning(ctx, "🌐 Network benchy-network already exists")
-	} else {
-		ns.feedback.Success(ctx, "🌐 Created network benchy-network")
+	if err := ns.dockerClient.CreateNetwork(ctx, dockerNetworkName, resourceLabels(ports.RoleNetwork)); err != nil {
+		return fmt.Errorf("failed to create docker network: %w", err)
 	}
-	ns.feedback.Success(ctx, "✅ Docker network created")
+	ns.feedback.Success(ctx, "✅ Docker network benchy-network ready")
 
 	// 3. Lancer tous les 5 nodes avec genesis init
 	progress, err := ns.feedback.StartProgress(ctx, "Launching nodes", 5)
@@ -69,7 +116,7 @@ func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	successCount := 0
 	
 	// Alice (Geth avec Genesis Init)
-	if err := ns.launchAliceNodeWithGenesis(ctx); err != nil {
+	if err := ns.launchGethNode(ctx, "alice", 8545, 30303, 9545, gethValidatorHTTPAPI); err != nil {
 		progress.Update(1, fmt.Sprintf("❌ alice failed: %v", err))
 	} else {
 		successCount++
@@ -78,7 +125,7 @@ func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	time.Sleep(2 * time.Second)
 
 	// Bob (Geth avec Genesis Init)
-	if err := ns.launchBobNodeWithGenesis(ctx); err != nil {
+	if err := ns.launchGethNode(ctx, "bob", 8546, 30304, 9546, gethValidatorHTTPAPI); err != nil {
 		progress.Update(2, fmt.Sprintf("❌ bob failed: %v", err))
 	} else {
 		successCount++
@@ -87,7 +134,7 @@ func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	time.Sleep(2 * time.Second)
 
 	// Cassandra (Nethermind)
-	if err := ns.launchCassandraNode(ctx); err != nil {
+	if err := ns.launchNethermindNode(ctx, "cassandra", 8547, 30305, 9547); err != nil {
 		progress.Update(3, fmt.Sprintf("❌ cassandra failed: %v", err))
 	} else {
 		successCount++
@@ -96,7 +143,7 @@ func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	time.Sleep(1 * time.Second)
 
 	// Driss (Geth avec Genesis)
-	if err := ns.launchDrissNodeWithGenesis(ctx); err != nil {
+	if err := ns.launchGethNode(ctx, "driss", 8548, 30306, 9548, gethHTTPAPI); err != nil {
 		progress.Update(4, fmt.Sprintf("❌ driss failed: %v", err))
 	} else {
 		successCount++
@@ -105,7 +152,7 @@ func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	time.Sleep(1 * time.Second)
 
 	// Elena (Nethermind)
-	if err := ns.launchElenaNode(ctx); err != nil {
+	if err := ns.launchNethermindNode(ctx, "elena", 8549, 30307, 9549); err != nil {
 		progress.Update(5, fmt.Sprintf("❌ elena failed: %v", err))
 	} else {
 		successCount++
@@ -127,215 +174,236 @@ func (ns *NetworkService) LaunchNetwork(ctx context.Context) error {
 	return nil
 }
 
-// launchAliceNodeWithGenesis lance Alice avec genesis init
-func (ns *NetworkService) launchAliceNodeWithGenesis(ctx context.Context) error {
-	// Étape 1: Init genesis
-	initCmd := []string{
-		"docker", "run", "--rm",
-		"-v", filepath.Join(ns.baseDir, "nodes/alice/data") + ":/data",
-		"-v", filepath.Join(ns.baseDir, "genesis.json") + ":/genesis.json",
-		"--network", "benchy-network",
-		"ethereum/client-go:v1.13.15",
-		"--datadir", "/data", "init", "/genesis.json",
+// DockerVersion retourne la version du daemon Docker ; échoue avec docker.ErrDaemonUnavailable s'il ne répond pas
+func (ns *NetworkService) DockerVersion(ctx context.Context) (string, error) {
+	return ns.dockerClient.ServerVersion(ctx)
+}
+
+// Teardown supprime les containers et les réseaux Docker de benchy, trouvés par leurs labels ; avec
+// removeVolumes, les volumes de données des nodes aussi. Les keystores et le genesis restent sur l'hôte.
+func (ns *NetworkService) Teardown(ctx context.Context, removeVolumes bool) error {
+	ns.feedback.Info(ctx, "🧹 Tearing down the benchy network...")
+	owned := map[string]string{ports.LabelNetwork: dockerNetworkName}
+
+	// 1. Containers (nodes, containers d'init ou d'outillage interrompus)
+	containers, err := ns.dockerClient.ListContainers(ctx, owned)
+	if err != nil {
+		return err
 	}
-	
-	fmt.Printf("DEBUG INIT: %s\n", strings.Join(initCmd[1:], " "))
-	execInitCmd := exec.CommandContext(ctx, initCmd[0], initCmd[1:]...)
-	if err := execInitCmd.Run(); err != nil {
-		return fmt.Errorf("failed to init alice genesis: %w", err)
+	for _, container := range containers {
+		if err := ns.dockerClient.RemoveContainer(ctx, container.ID); err != nil && !errors.Is(err, docker.ErrContainerNotFound) {
+			return err
+		}
+		ns.feedback.Info(ctx, fmt.Sprintf("   - Removed container %s", container.Name))
 	}
 
-	// Étape 2: Lancer le node
-	cmd := []string{
-		"docker", "run", "-d",
-		"--name", "benchy-alice",
-		"-p", "8545:8545",
-		"-p", "30303:30303",
-		"-v", filepath.Join(ns.baseDir, "nodes/alice/data") + ":/data",
-		"-v", filepath.Join(ns.baseDir, "genesis.json") + ":/genesis.json",
-		"--network", "benchy-network",
-		"ethereum/client-go:v1.13.15",
-		"--datadir", "/data",
-		"--networkid", "1337",
-		"--port", "30303",
-		"--http", "--http.addr", "0.0.0.0", "--http.port", "8545",
-		"--http.api", "eth,net,web3,personal,miner,clique",
-		"--http.corsdomain", "*",
-		"--allow-insecure-unlock",
-		"--nodiscover", "--maxpeers", "25",
-		"--syncmode", "full", "--verbosity", "3",
-		
-	}
-
-	fmt.Printf("DEBUG RUN: %s\n", strings.Join(cmd[1:], " "))
-	execCmd := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
-	output, err := execCmd.Output()
+	// 2. Réseaux, une fois vidés de leurs containers
+	networks, err := ns.dockerClient.ListNetworks(ctx, owned)
 	if err != nil {
-		return fmt.Errorf("failed to create alice container: %w", err)
+		return err
+	}
+	for _, networkName := range networks {
+		if err := ns.dockerClient.RemoveNetwork(ctx, networkName); err != nil && !errors.Is(err, docker.ErrNetworkNotFound) {
+			return err
+		}
+		ns.feedback.Info(ctx, fmt.Sprintf("   - Removed network %s", networkName))
+	}
+
+	// 3. Volumes de données, conservés par défaut
+	volumes, err := ns.dockerClient.ListVolumes(ctx, owned)
+	if err != nil {
+		return err
+	}
+	removedVolumes := 0
+	if removeVolumes {
+		for _, volumeName := range volumes {
+			if err := ns.dockerClient.RemoveVolume(ctx, volumeName); err != nil && !errors.Is(err, docker.ErrVolumeNotFound) {
+				return err
+			}
+			ns.feedback.Info(ctx, fmt.Sprintf("   - Removed volume %s", volumeName))
+			removedVolumes++
+		}
+	} else if len(volumes) > 0 {
+		ns.feedback.Info(ctx, fmt.Sprintf("💾 %d data volumes kept (use --volumes to remove them)", len(volumes)))
 	}
 
-	containerID := strings.TrimSpace(string(output))
-	fmt.Printf("🐳 Created container benchy-alice with ID %s\n", containerID[:12])
+	ns.feedback.Success(ctx, fmt.Sprintf("✅ Removed %d containers, %d networks and %d volumes", len(containers), len(networks), removedVolumes))
 	return nil
 }
 
-// launchBobNodeWithGenesis lance Bob avec genesis init
-func (ns *NetworkService) launchBobNodeWithGenesis(ctx context.Context) error {
-	// Étape 1: Init genesis
-	initCmd := []string{
-		"docker", "run", "--rm",
-		"-v", filepath.Join(ns.baseDir, "nodes/bob/data") + ":/data",
-		"-v", filepath.Join(ns.baseDir, "genesis.json") + ":/genesis.json",
-		"--network", "benchy-network",
-		"ethereum/client-go:v1.13.15",
-		"--datadir", "/data", "init", "/genesis.json",
+// EnsureConfiguration génère clés et genesis s'ils n'existent pas encore, sans lancer de container
+func (ns *NetworkService) EnsureConfiguration(ctx context.Context) error {
+	if _, err := os.Stat(filepath.Join(ns.baseDir, "genesis.json")); err == nil {
+		return nil
 	}
-	
-	fmt.Printf("DEBUG INIT: %s\n", strings.Join(initCmd[1:], " "))
-	execInitCmd := exec.CommandContext(ctx, initCmd[0], initCmd[1:]...)
-	if err := execInitCmd.Run(); err != nil {
-		return fmt.Errorf("failed to init bob genesis: %w", err)
+
+	ns.feedback.Info(ctx, "📋 No network configuration yet, generating keys and genesis:")
+	if err := ns.generateConfiguration(ctx); err != nil {
+		return fmt.Errorf("failed to generate configuration: %w", err)
 	}
+	return nil
+}
 
-	// Étape 2: Lancer le node
-	cmd := []string{
-		"docker", "run", "-d",
-		"--name", "benchy-bob",
-		"-p", "8546:8546",
-		"-p", "30304:30304",
-		"-v", filepath.Join(ns.baseDir, "nodes/bob/data") + ":/data",
-		"-v", filepath.Join(ns.baseDir, "genesis.json") + ":/genesis.json",
-		"--network", "benchy-network",
-		"ethereum/client-go:v1.13.15",
-		"--datadir", "/data",
-		"--networkid", "1337",
-		"--port", "30304",
-		"--http", "--http.addr", "0.0.0.0", "--http.port", "8546",
-		"--http.api", "eth,net,web3,personal,miner,clique",
-		"--http.corsdomain", "*",
-		"--allow-insecure-unlock",
-		"--nodiscover", "--maxpeers", "25",
-		"--syncmode", "full", "--verbosity", "3",
-		
-	}
-
-	fmt.Printf("DEBUG RUN: %s\n", strings.Join(cmd[1:], " "))
-	execCmd := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
-	output, err := execCmd.Output()
+// generateConfiguration écrit les keystores des nodes et le genesis.json qui les finance. Avec une
+// mnémonique, les clés (donc les adresses et allocations) sont identiques à chaque lancement.
+func (ns *NetworkService) generateConfiguration(ctx context.Context) error {
+	// Sans keystores complets, les clés sont créées : la passphrase saisie est confirmée
+	askPassphrase := keystorePassphrase
+	for _, name := range nodeNames {
+		if !config.KeyExists(config.NodeKeystoreDir(ns.baseDir, name), name) {
+			askPassphrase = newKeystorePassphrase
+			break
+		}
+	}
+	passphrase, err := askPassphrase(ctx, ns.feedback)
 	if err != nil {
-		return fmt.Errorf("failed to create bob container: %w", err)
+		return err
+	}
+
+	manager := config.NewNodeConfigManager(ns.baseDir)
+	manager.SetPassphrase(passphrase)
+	if err := manager.SetMnemonic(ns.mnemonic, ns.extraAccounts); err != nil {
+		return err
+	}
+	if err := manager.LoadExistingConfigurations(); err != nil {
+		// Une mnémonique ne remplace les clés déjà enregistrées qu'avec l'accord de l'utilisateur
+		var conflict *config.KeyConflictError
+		if !errors.As(err, &conflict) {
+			return err
+		}
+		confirmed, err := ns.feedback.Confirm(ctx, fmt.Sprintf("⚠️  The mnemonic replaces the current keys of %s, which will be lost. Continue?", strings.Join(conflict.Names, ", ")))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return fmt.Errorf("%w: launch without --mnemonic to keep them", conflict)
+		}
+		manager.AllowKeyOverwrite()
+		if err := manager.LoadExistingConfigurations(); err != nil {
+			return err
+		}
+	}
+	if err := manager.SaveAllConfigurations(); err != nil {
+		return err
+	}
+
+	if ns.mnemonic != "" {
+		ns.feedback.Info(ctx, fmt.Sprintf("   - Accounts derived from mnemonic (m/44'/60'/0'/0/0-%d)", len(nodeNames)+ns.extraAccounts-1))
+	}
+	for _, node := range manager.GetAllNodes() {
+		ns.feedback.Info(ctx, fmt.Sprintf("   - %-10s %s", displayName(node.Name), node.KeyPair.Address.Hex()))
+	}
+	for i, account := range manager.GetExtraAccounts() {
+		ns.feedback.Info(ctx, fmt.Sprintf("   - %-10s %s (100 ETH)", config.ExtraAccountName(i), account.Address.Hex()))
 	}
 
-	containerID := strings.TrimSpace(string(output))
-	fmt.Printf("🐳 Created container benchy-bob with ID %s\n", containerID[:12])
 	return nil
 }
 
-// launchDrissNodeWithGenesis lance Driss avec genesis
-func (ns *NetworkService) launchDrissNodeWithGenesis(ctx context.Context) error {
-	// Init genesis
-	initCmd := []string{
-		"docker", "run", "--rm",
-		"-v", filepath.Join(ns.baseDir, "nodes/driss/data") + ":/data",
-		"-v", filepath.Join(ns.baseDir, "genesis.json") + ":/genesis.json",
-		"--network", "benchy-network",
-		"ethereum/client-go:v1.13.15",
-		"--datadir", "/data", "init", "/genesis.json",
+// launchGethNode initialise le datadir d'un node Geth avec le genesis, puis lance le node
+func (ns *NetworkService) launchGethNode(ctx context.Context, name string, rpcPort, p2pPort, wsPort int, httpAPI string) error {
+	volumes := map[string]string{
+		filepath.Join(ns.baseDir, "nodes", name, "data"): "/data",
+		filepath.Join(ns.baseDir, "genesis.json"):        "/genesis.json",
 	}
-	
-	execInitCmd := exec.CommandContext(ctx, initCmd[0], initCmd[1:]...)
-	if err := execInitCmd.Run(); err != nil {
-		return fmt.Errorf("failed to init driss genesis: %w", err)
-	}
-
-	// Lancer node
-	cmd := []string{
-		"docker", "run", "-d",
-		"--name", "benchy-driss",
-		"-p", "8548:8548",
-		"-p", "30306:30306",
-		"-v", filepath.Join(ns.baseDir, "nodes/driss/data") + ":/data",
-		"-v", filepath.Join(ns.baseDir, "genesis.json") + ":/genesis.json",
-		"--network", "benchy-network",
-		"ethereum/client-go:v1.13.15",
-		"--datadir", "/data",
-		"--networkid", "1337",
-		"--port", "30306",
-		"--http", "--http.addr", "0.0.0.0", "--http.port", "8548",
-		"--http.api", "eth,net,web3,personal,clique",
-		"--http.corsdomain", "*",
-		"--allow-insecure-unlock",
-		"--nodiscover", "--maxpeers", "25",
-		"--syncmode", "full", "--verbosity", "3",
-	}
-
-	execCmd := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
-	output, err := execCmd.Output()
+
+	// Étape 1: Init genesis dans un container éphémère
+	if err := ns.dockerClient.RunContainer(ctx, ports.ContainerConfig{
+		Image:       gethImage,
+		Name:        containerName(name) + "-init",
+		Volumes:     volumes,
+		NetworkMode: dockerNetworkName,
+		Labels:      nodeLabels(ports.RoleInit, name, entities.ClientGeth),
+		Command:     []string{"--datadir", "/data", "init", "/genesis.json"},
+	}); err != nil {
+		return fmt.Errorf("failed to init %s genesis: %w", name, err)
+	}
+
+	// Étape 2: Lancer le node
+	containerID, err := ns.dockerClient.CreateContainer(ctx, nil, ports.ContainerConfig{
+		Image:       gethImage,
+		Name:        containerName(name),
+		Ports:       nodePorts(rpcPort, p2pPort, wsPort),
+		Volumes:     volumes,
+		NetworkMode: dockerNetworkName,
+		Labels:      nodeLabels(ports.RoleNode, name, entities.ClientGeth),
+		Command: []string{
+			"--datadir", "/data",
+			"--networkid", "1337",
+			"--port", strconv.Itoa(p2pPort),
+			"--http", "--http.addr", "0.0.0.0", "--http.port", strconv.Itoa(rpcPort),
+			"--ws", "--ws.addr", "0.0.0.0", "--ws.port", strconv.Itoa(wsPort),
+			"--ws.api", gethWSAPI,
+			"--http.api", httpAPI,
+			"--allow-insecure-unlock",
+			"--nodiscover", "--maxpeers", "25",
+			"--syncmode", "full", "--verbosity", "3",
+		},
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create driss container: %w", err)
+		return fmt.Errorf("failed to create %s container: %w", name, err)
 	}
 
-	containerID := strings.TrimSpace(string(output))
-	fmt.Printf("🐳 Created container benchy-driss with ID %s\n", containerID[:12])
-	return nil
+	return ns.startNode(ctx, name, containerID)
 }
 
-// launchCassandraNode lance Cassandra avec Nethermind
-func (ns *NetworkService) launchCassandraNode(ctx context.Context) error {
-	cmd := []string{
-		"docker", "run", "-d",
-		"--name", "benchy-cassandra",
-		"-p", "8547:8547",
-		"-p", "30305:30305",
-		"--network", "benchy-network",
-		"nethermind/nethermind:latest",
-		"--config", "mainnet",
-		"--JsonRpc.Enabled", "true",
-		"--JsonRpc.Host", "0.0.0.0",
-		"--JsonRpc.Port", "8547",
-		"--Network.DiscoveryPort", "30305",
-		"--Network.P2PPort", "30305",
-	}
-
-	execCmd := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
-	output, err := execCmd.Output()
+// launchNethermindNode lance un node Nethermind, avec sa base dans un volume Docker labellisé
+func (ns *NetworkService) launchNethermindNode(ctx context.Context, name string, rpcPort, p2pPort, wsPort int) error {
+	volumeName := nodeVolumeName(name)
+	if err := ns.dockerClient.CreateVolume(ctx, volumeName, nodeLabels(ports.RoleData, name, entities.ClientNethermind)); err != nil {
+		return fmt.Errorf("failed to create %s data volume: %w", name, err)
+	}
+
+	containerID, err := ns.dockerClient.CreateContainer(ctx, nil, ports.ContainerConfig{
+		Image:       nethermindImage,
+		Name:        containerName(name),
+		Ports:       nodePorts(rpcPort, p2pPort, wsPort),
+		Volumes:     map[string]string{volumeName: "/data"},
+		NetworkMode: dockerNetworkName,
+		Labels:      nodeLabels(ports.RoleNode, name, entities.ClientNethermind),
+		Command: []string{
+			"--config", "mainnet",
+			"--datadir", "/data",
+			"--JsonRpc.Enabled", "true",
+			"--JsonRpc.Host", "0.0.0.0",
+			"--JsonRpc.Port", strconv.Itoa(rpcPort),
+			"--JsonRpc.WebSocketsPort", strconv.Itoa(wsPort),
+			"--Init.WebSocketsEnabled", "true",
+			"--JsonRpc.EnabledModules", entities.NethermindRPCModules,
+			"--Network.DiscoveryPort", strconv.Itoa(p2pPort),
+			"--Network.P2PPort", strconv.Itoa(p2pPort),
+		},
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create cassandra container: %w", err)
+		return fmt.Errorf("failed to create %s container: %w", name, err)
 	}
 
-	containerID := strings.TrimSpace(string(output))
-	fmt.Printf("🐳 Created container benchy-cassandra with ID %s\n", containerID[:12])
-	return nil
+	return ns.startNode(ctx, name, containerID)
 }
 
-// launchElenaNode lance Elena avec Nethermind
-func (ns *NetworkService) launchElenaNode(ctx context.Context) error {
-	cmd := []string{
-		"docker", "run", "-d",
-		"--name", "benchy-elena",
-		"-p", "8549:8549",
-		"-p", "30307:30307",
-		"--network", "benchy-network",
-		"nethermind/nethermind:latest",
-		"--config", "mainnet",
-		"--JsonRpc.Enabled", "true",
-		"--JsonRpc.Host", "0.0.0.0",
-		"--JsonRpc.Port", "8549",
-		"--Network.DiscoveryPort", "30307",
-		"--Network.P2PPort", "30307",
-	}
-
-	execCmd := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
-	output, err := execCmd.Output()
-	if err != nil {
-		return fmt.Errorf("failed to create elena container: %w", err)
+// startNode démarre le container d'un node ; s'il ne démarre pas (port déjà pris...), il est supprimé
+// pour qu'un nouveau lancement ne bute pas sur son nom
+func (ns *NetworkService) startNode(ctx context.Context, name string, containerID string) error {
+	if err := ns.dockerClient.StartContainer(ctx, containerID); err != nil {
+		ns.dockerClient.RemoveContainer(ctx, containerID)
+		return fmt.Errorf("failed to start %s container: %w", name, err)
 	}
 
-	containerID := strings.TrimSpace(string(output))
-	fmt.Printf("🐳 Created container benchy-elena with ID %s\n", containerID[:12])
+	ns.feedback.Info(ctx, fmt.Sprintf("🐳 Started container %s (%s)", containerName(name), containerID[:12]))
 	return nil
 }
 
+// nodePorts publie les ports JSON-RPC, P2P et WebSocket d'un node sur les mêmes ports de l'hôte ; le
+// WebSocket, qui sert le module admin, n'écoute que sur localhost
+func nodePorts(rpcPort, p2pPort, wsPort int) map[string]string {
+	nodePorts := make(map[string]string)
+	for _, port := range []int{rpcPort, p2pPort} {
+		nodePorts[strconv.Itoa(port)] = strconv.Itoa(port)
+	}
+	nodePorts["127.0.0.1:"+strconv.Itoa(wsPort)] = strconv.Itoa(wsPort)
+	return nodePorts
+}
+
 // createNetworkEntity crée l'entité Network pour le monitoring  
 func (ns *NetworkService) createNetworkEntity() *entities.Network {
 	chainID := big.NewInt(1337)
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/docker"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// dockerNetworkName est le réseau Docker partagé par les containers benchy
+const dockerNetworkName = "benchy-network"
+
+// nodeNames liste les nodes du réseau benchy, dans l'ordre d'affichage
+var nodeNames = []string{"alice", "bob", "cassandra", "driss", "elena"}
+
+// nodeRPCPorts associe chaque node à son port RPC
+var nodeRPCPorts = map[string]int{
+	"alice":     8545,
+	"bob":       8546,
+	"cassandra": 8547,
+	"driss":     8548,
+	"elena":     8549,
+}
+
+// validatorNodes liste les validateurs du genesis
+var validatorNodes = map[string]bool{"alice": true, "bob": true, "cassandra": true}
+
+// nodeRPCURL retourne l'URL JSON-RPC d'un node
+func nodeRPCURL(name string) string {
+	return fmt.Sprintf("http://localhost:%d", nodeRPCPorts[name])
+}
+
+// nodeWSURL retourne l'URL WebSocket d'un node (port RPC + 1000)
+func nodeWSURL(name string) string {
+	return fmt.Sprintf("ws://localhost:%d", nodeRPCPorts[name]+1000)
+}
+
+// nodeAdminURL retourne l'URL du module admin d'un node : il n'est servi que sur le WebSocket, publié
+// sur localhost
+func nodeAdminURL(name string) string {
+	return nodeWSURL(name)
+}
+
+// containerName retourne le nom du container Docker d'un node
+func containerName(name string) string {
+	return "benchy-" + name
+}
+
+// nodeVolumeName retourne le nom du volume Docker de données d'un node
+func nodeVolumeName(name string) string {
+	return containerName(name) + "-data"
+}
+
+// resourceLabels retourne les labels d'une ressource Docker du réseau benchy
+func resourceLabels(role string) map[string]string {
+	return map[string]string{
+		ports.LabelNetwork: dockerNetworkName,
+		ports.LabelRole:    role,
+	}
+}
+
+// nodeLabels retourne les labels d'une ressource Docker propre à un node (container, volume)
+func nodeLabels(role string, name string, client entities.ClientType) map[string]string {
+	labels := resourceLabels(role)
+	labels[ports.LabelNodeName] = name
+	labels[ports.LabelNodeValidator] = strconv.FormatBool(validatorNodes[name])
+	labels[ports.LabelNodeClient] = string(client)
+	return labels
+}
+
+// nodeContainers retrouve par leurs labels les containers des nodes benchy, arrêtés compris, indexés
+// par nom de node : un container sans ces labels n'appartient pas au réseau, même nommé benchy-*
+func nodeContainers(ctx context.Context, dockerClient *docker.DockerClient) (map[string]*ports.ContainerInfo, error) {
+	containers, err := dockerClient.ListContainers(ctx, resourceLabels(ports.RoleNode))
+	if err != nil {
+		return nil, err
+	}
+
+	byNode := make(map[string]*ports.ContainerInfo)
+	for _, container := range containers {
+		name := container.Labels[ports.LabelNodeName]
+		if _, known := nodeRPCPorts[name]; known {
+			byNode[name] = container
+		}
+	}
+	return byNode, nil
+}
+
+// nodeContainer retrouve par ses labels le container d'un node ; docker.ErrContainerNotFound s'il n'existe pas
+func nodeContainer(ctx context.Context, dockerClient *docker.DockerClient, name string) (*ports.ContainerInfo, error) {
+	containers, err := nodeContainers(ctx, dockerClient)
+	if err != nil {
+		return nil, err
+	}
+	container, found := containers[name]
+	if !found {
+		return nil, fmt.Errorf("%s: %w", name, docker.ErrContainerNotFound)
+	}
+	return container, nil
+}
+
+// selectNodes valide les nodes demandés, éventuellement séparés par des virgules ; aucun node : tous
+func selectNodes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nodeNames, nil
+	}
+
+	var nodes []string
+	seen := make(map[string]bool)
+	for _, node := range requested {
+		for _, name := range strings.Split(node, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" || seen[name] {
+				continue
+			}
+			if _, exists := nodeRPCPorts[name]; !exists {
+				return nil, fmt.Errorf("unknown node: %s", name)
+			}
+			seen[name] = true
+			nodes = append(nodes, name)
+		}
+	}
+	return nodes, nil
+}
+
+// loadNodeAddresses charge l'adresse de chaque node depuis son keystore (les nodes absents sont ignorés)
+func loadNodeAddresses(baseDir string) map[string]common.Address {
+	addresses := make(map[string]common.Address)
+	for _, name := range nodeNames {
+		address, err := config.LoadAddressFromFile(config.NodeKeystoreDir(baseDir, name), name)
+		if err == nil {
+			addresses[name] = address
+		}
+	}
+	return addresses
+}
+
+// nodeNameByAddress retourne le nom du node propriétaire d'une adresse, ou l'adresse abrégée
+func nodeNameByAddress(addresses map[string]common.Address, address common.Address) string {
+	for name, nodeAddress := range addresses {
+		if nodeAddress == address {
+			return name
+		}
+	}
+	return shortAddress(address)
+}
+
+// resolveAddress accepte un nom de node, un compte importé (account1…) ou une adresse hexadécimale
+func resolveAddress(baseDir string, target string) (common.Address, error) {
+	if common.IsHexAddress(target) {
+		return common.HexToAddress(target), nil
+	}
+
+	name := strings.ToLower(target)
+	if _, exists := nodeRPCPorts[name]; exists {
+		return config.LoadAddressFromFile(config.NodeKeystoreDir(baseDir, name), name)
+	}
+	if config.KeyExists(config.AccountsKeystoreDir(baseDir), name) {
+		return config.LoadAddressFromFile(config.AccountsKeystoreDir(baseDir), name)
+	}
+
+	return common.Address{}, fmt.Errorf("unknown node or address: %s", target)
+}
+
+// shortAddress abrège une adresse pour les tableaux (0x1234…abcd)
+func shortAddress(address common.Address) string {
+	hex := address.Hex()
+	return hex[:6] + "…" + hex[len(hex)-4:]
+}
+
+// displayName met en forme le nom d'un node pour l'affichage (driss → Driss)
+func displayName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,471 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/docker"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/feedback"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// partitionChain est la chaîne iptables qui porte les règles de la partition dans chaque node
+	partitionChain = "BENCHY-PARTITION"
+	// partitionPollInterval est la période d'observation des deux branches (une par bloc Clique)
+	partitionPollInterval = 5 * time.Second
+	// partitionHealTimeout borne l'attente de la convergence des nodes après la réunification
+	partitionHealTimeout = 2 * time.Minute
+	// partitionQueryTimeout borne chaque relevé des branches (quelques requêtes par bloc divergent)
+	partitionQueryTimeout = 30 * time.Second
+	// forkSearchDepth borne la recherche de l'ancêtre commun des deux branches
+	forkSearchDepth = 256
+)
+
+// PartitionService coupe le réseau Docker entre deux groupes de nodes avec iptables, observe les
+// branches qui en résultent, puis réunifie le réseau et mesure les réorganisations
+type PartitionService struct {
+	dockerClient *docker.DockerClient
+	ethClient    *ethereum.EthereumClient
+	feedback     *feedback.ConsoleFeedback
+}
+
+// NewPartitionService crée un nouveau service de partition
+func NewPartitionService() (*PartitionService, error) {
+	fb := feedback.NewConsoleFeedback()
+	dockerClient, err := docker.NewDockerClient(fb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+
+	return &PartitionService{
+		dockerClient: dockerClient,
+		ethClient:    ethereum.NewEthereumClient(),
+		feedback:     fb,
+	}, nil
+}
+
+// partitionTarget est le container d'un node et son adresse sur le réseau Docker
+type partitionTarget struct {
+	containerID string
+	ip          string
+}
+
+// forkState décrit les deux branches observées pendant la partition
+type forkState struct {
+	heads    map[string]*ports.BlockHeader // Dernier bloc de chaque node joignable
+	bestA    string                        // Node le plus avancé du groupe A
+	bestB    string                        // Node le plus avancé du groupe B
+	ancestor uint64                        // Dernier bloc commun aux deux groupes
+	diverged bool                          // Les deux groupes ont scellé des blocs différents
+}
+
+// nodeBranch garde les blocs d'un node postérieurs à l'ancêtre commun, au moment de la réunification
+type nodeBranch struct {
+	from   uint64        // Numéro du premier bloc de hashes
+	hashes []common.Hash // Hashes des blocs from, from+1... jusqu'à la tête du node
+}
+
+// ParsePartition interprète les deux groupes de la commande partition
+func (ps *PartitionService) ParsePartition(groupA string, groupB string) (*entities.Partition, error) {
+	return entities.ParsePartition(groupA, groupB, nodeNames)
+}
+
+// Run sépare les deux groupes pendant duration en affichant la divergence des branches, puis réunifie
+// le réseau et affiche la profondeur de réorganisation de chaque node. Si ctx est annulé (Ctrl+C),
+// le réseau est réunifié sans attendre la convergence.
+func (ps *PartitionService) Run(ctx context.Context, partition *entities.Partition, duration time.Duration) error {
+	ps.feedback.Info(ctx, fmt.Sprintf("✂️  Partitioning the network for %s: %s", formatAge(duration), partition))
+
+	// 1. Containers et IP des nodes sur benchy-network
+	targets, err := ps.partitionTargets(ctx, partition.Nodes())
+	if err != nil {
+		return err
+	}
+
+	// 2. Chaque node rejette le trafic du groupe opposé
+	if err := ps.split(ctx, partition, targets); err != nil {
+		ps.heal(context.Background(), targets)
+		return err
+	}
+	ps.feedback.Success(ctx, fmt.Sprintf("✅ Network split: %s cannot reach %s",
+		strings.Join(partition.GroupA, ", "), strings.Join(partition.GroupB, ", ")))
+
+	// 3. Observation des branches jusqu'à la fin de la partition
+	splitCtx, cancel := context.WithTimeout(ctx, duration)
+	fork := ps.watchFork(splitCtx, partition)
+	cancel()
+
+	// La réunification ne doit pas dépendre de ctx, qui peut avoir été annulé
+	healCtx := context.Background()
+	branches := ps.recordBranches(healCtx, partition, fork)
+
+	// 4. Réunification
+	if err := ps.heal(healCtx, targets); err != nil {
+		return err
+	}
+	ps.feedback.Success(ctx, "✅ Network healed")
+
+	if err := ctx.Err(); err != nil {
+		ps.feedback.Warning(ctx, "⚠️  Partition interrupted: reorg depth not measured")
+		return err
+	}
+
+	// 5. Convergence et profondeur des réorganisations
+	return ps.reportReorgs(healCtx, partition, branches)
+}
+
+// Heal retire les règles de partition de tous les nodes en cours d'exécution (après une partition
+// interrompue brutalement)
+func (ps *PartitionService) Heal(ctx context.Context) error {
+	containers, err := nodeContainers(ctx, ps.dockerClient)
+	if err != nil {
+		return err
+	}
+
+	var running []string
+	for _, name := range nodeNames {
+		if container, found := containers[name]; found && container.State == "running" {
+			running = append(running, name)
+		}
+	}
+	if len(running) == 0 {
+		ps.feedback.Warning(ctx, "⚠️  No running benchy node to heal")
+		return nil
+	}
+
+	targets, err := ps.partitionTargets(ctx, running)
+	if err != nil {
+		return err
+	}
+	if err := ps.heal(ctx, targets); err != nil {
+		return err
+	}
+	ps.feedback.Success(ctx, fmt.Sprintf("✅ Partition rules removed from %s", strings.Join(running, ", ")))
+	return nil
+}
+
+// partitionTargets retrouve le container et l'IP de chaque node ; tous doivent être en cours d'exécution
+func (ps *PartitionService) partitionTargets(ctx context.Context, nodes []string) (map[string]partitionTarget, error) {
+	containers, err := nodeContainers(ctx, ps.dockerClient)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]partitionTarget)
+	for _, node := range nodes {
+		container, found := containers[node]
+		if !found {
+			return nil, fmt.Errorf("%s has no container: launch the network first", node)
+		}
+		if container.State != "running" {
+			return nil, fmt.Errorf("%s is not running (%s)", node, container.Status)
+		}
+
+		ip, err := ps.dockerClient.GetContainerIP(ctx, container.ID, dockerNetworkName)
+		if err != nil {
+			return nil, err
+		}
+		targets[node] = partitionTarget{containerID: container.ID, ip: ip}
+	}
+	return targets, nil
+}
+
+// split pose dans chaque node les règles qui rejettent le trafic des nodes du groupe opposé
+func (ps *PartitionService) split(ctx context.Context, partition *entities.Partition, targets map[string]partitionTarget) error {
+	for _, node := range partition.Nodes() {
+		var blocked []string
+		for _, peer := range partition.Opposite(node) {
+			blocked = append(blocked, targets[peer].ip)
+		}
+
+		if err := runNetworkHelper(ctx, ps.dockerClient, node, targets[node].containerID, partitionScript(blocked)); err != nil {
+			return err
+		}
+		ps.feedback.Info(ctx, fmt.Sprintf("   - %s drops traffic from %s", node, strings.Join(partition.Opposite(node), ", ")))
+	}
+	return nil
+}
+
+// heal retire la chaîne de partition de chaque node ; les erreurs n'interrompent pas les autres nodes
+func (ps *PartitionService) heal(ctx context.Context, targets map[string]partitionTarget) error {
+	var errs []error
+	for _, node := range nodeNames {
+		target, found := targets[node]
+		if !found {
+			continue
+		}
+		if err := runNetworkHelper(ctx, ps.dockerClient, node, target.containerID, healScript()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// partitionScript crée la chaîne de partition (vidée si elle existe) et y rejette les IP données,
+// dans les deux sens
+func partitionScript(blocked []string) string {
+	commands := []string{
+		fmt.Sprintf("iptables -N %[1]s 2>/dev/null || iptables -F %[1]s", partitionChain),
+		fmt.Sprintf("iptables -C INPUT -j %[1]s 2>/dev/null || iptables -I INPUT -j %[1]s", partitionChain),
+		fmt.Sprintf("iptables -C OUTPUT -j %[1]s 2>/dev/null || iptables -I OUTPUT -j %[1]s", partitionChain),
+	}
+	for _, ip := range blocked {
+		commands = append(commands,
+			fmt.Sprintf("iptables -A %s -s %s -j DROP", partitionChain, ip),
+			fmt.Sprintf("iptables -A %s -d %s -j DROP", partitionChain, ip),
+		)
+	}
+	return "set -e; " + strings.Join(commands, "; ")
+}
+
+// healScript retire la chaîne de partition ; sans chaîne, il ne fait rien
+func healScript() string {
+	return fmt.Sprintf("iptables -D INPUT -j %[1]s 2>/dev/null; iptables -D OUTPUT -j %[1]s 2>/dev/null; "+
+		"iptables -F %[1]s 2>/dev/null; iptables -X %[1]s 2>/dev/null; true", partitionChain)
+}
+
+// watchFork affiche à chaque bloc la tête de chaque groupe et la divergence depuis l'ancêtre commun,
+// jusqu'à l'annulation de ctx ; retourne la dernière observation
+func (ps *PartitionService) watchFork(ctx context.Context, partition *entities.Partition) *forkState {
+	start := time.Now()
+	ticker := time.NewTicker(partitionPollInterval)
+	defer ticker.Stop()
+
+	var last *forkState
+	for {
+		// Les requêtes d'une observation ne sont pas coupées par la fin de la partition
+		observeCtx, cancel := context.WithTimeout(context.Background(), partitionQueryTimeout)
+		if fork, err := ps.observeFork(observeCtx, partition); err == nil {
+			last = fork
+			ps.feedback.Info(ctx, fmt.Sprintf("   %6s  %s", formatAge(time.Since(start)), describeFork(partition, fork)))
+		} else {
+			ps.feedback.Warning(ctx, fmt.Sprintf("⚠️  %v", err))
+		}
+		cancel()
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return last
+		}
+	}
+}
+
+// observeFork relève la tête de chaque node et l'ancêtre commun des nodes les plus avancés de chaque groupe
+func (ps *PartitionService) observeFork(ctx context.Context, partition *entities.Partition) (*forkState, error) {
+	fork := &forkState{heads: make(map[string]*ports.BlockHeader)}
+	for _, node := range partition.Nodes() {
+		if head, err := ps.ethClient.GetHeaderByNumber(ctx, nodeRPCURL(node), nil); err == nil {
+			fork.heads[node] = head
+		}
+	}
+
+	fork.bestA = bestNode(partition.GroupA, fork.heads)
+	fork.bestB = bestNode(partition.GroupB, fork.heads)
+	if fork.bestA == "" || fork.bestB == "" {
+		return nil, fmt.Errorf("no node answered in one of the groups")
+	}
+
+	headA, headB := fork.heads[fork.bestA], fork.heads[fork.bestB]
+	number := headA.Number
+	if headB.Number < number {
+		number = headB.Number
+	}
+
+	ancestor, err := ps.commonAncestor(ctx, fork.bestA, fork.bestB, number)
+	if err != nil {
+		return nil, err
+	}
+	fork.ancestor = ancestor
+	fork.diverged = ancestor < number
+	return fork, nil
+}
+
+// commonAncestor cherche, en descendant depuis number, le dernier bloc identique sur les deux nodes
+func (ps *PartitionService) commonAncestor(ctx context.Context, nodeA string, nodeB string, number uint64) (uint64, error) {
+	for depth := 0; depth < forkSearchDepth; depth++ {
+		headerA, err := ps.ethClient.GetHeaderByNumber(ctx, nodeRPCURL(nodeA), &number)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", nodeA, err)
+		}
+		headerB, err := ps.ethClient.GetHeaderByNumber(ctx, nodeRPCURL(nodeB), &number)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", nodeB, err)
+		}
+
+		if headerA.Hash == headerB.Hash || number == 0 {
+			return number, nil
+		}
+		number--
+	}
+	return 0, fmt.Errorf("no common block in the last %d blocks of %s and %s", forkSearchDepth, nodeA, nodeB)
+}
+
+// bestNode retourne le node du groupe dont la tête est la plus haute ("" si aucun n'a répondu)
+func bestNode(group []string, heads map[string]*ports.BlockHeader) string {
+	best := ""
+	for _, node := range group {
+		if head, found := heads[node]; found && (best == "" || head.Number > heads[best].Number) {
+			best = node
+		}
+	}
+	return best
+}
+
+// describeFork met une observation sur une ligne :
+// "A #132 0x1234…abcd | B #127 0x5678…ef01 | fork at #126: +6 / +1 blocks"
+func describeFork(partition *entities.Partition, fork *forkState) string {
+	headA, headB := fork.heads[fork.bestA], fork.heads[fork.bestB]
+	line := fmt.Sprintf("%s #%d %s | %s #%d %s",
+		strings.Join(partition.GroupA, ","), headA.Number, shortHash(headA.Hash),
+		strings.Join(partition.GroupB, ","), headB.Number, shortHash(headB.Hash))
+
+	if !fork.diverged {
+		return line + " | same chain"
+	}
+	return line + fmt.Sprintf(" | fork at #%d: +%d / +%d blocks",
+		fork.ancestor, headA.Number-fork.ancestor, headB.Number-fork.ancestor)
+}
+
+// recordBranches relève, juste avant la réunification, les blocs de chaque node depuis l'ancêtre commun
+func (ps *PartitionService) recordBranches(ctx context.Context, partition *entities.Partition, fork *forkState) map[string]nodeBranch {
+	ctx, cancel := context.WithTimeout(ctx, partitionQueryTimeout)
+	defer cancel()
+
+	from := uint64(0)
+	if fork != nil {
+		from = fork.ancestor + 1
+	}
+
+	branches := make(map[string]nodeBranch)
+	for _, node := range partition.Nodes() {
+		head, err := ps.ethClient.GetHeaderByNumber(ctx, nodeRPCURL(node), nil)
+		if err != nil || head.Number < from {
+			continue
+		}
+
+		start := from
+		if head.Number-start >= forkSearchDepth {
+			start = head.Number - forkSearchDepth + 1
+		}
+
+		branch := nodeBranch{from: start}
+		for number := start; number <= head.Number; number++ {
+			header, err := ps.ethClient.GetHeaderByNumber(ctx, nodeRPCURL(node), &number)
+			if err != nil {
+				break
+			}
+			branch.hashes = append(branch.hashes, header.Hash)
+		}
+		branches[node] = branch
+	}
+	return branches
+}
+
+// reportReorgs attend que tous les nodes s'accordent sur le plus haut bloc relevé avant la réunification,
+// puis affiche pour chaque node le nombre de ses blocs remplacés
+func (ps *PartitionService) reportReorgs(ctx context.Context, partition *entities.Partition, branches map[string]nodeBranch) error {
+	var target uint64
+	for _, branch := range branches {
+		if top := branch.from + uint64(len(branch.hashes)); top > 0 && top-1 > target {
+			target = top - 1
+		}
+	}
+
+	spinner, err := ps.feedback.StartSpinner(ctx, fmt.Sprintf("Waiting for the nodes to agree on block #%d...", target))
+	if err != nil {
+		return err
+	}
+	if err := ps.waitForConvergence(ctx, partition.Nodes(), target); err != nil {
+		spinner.Error("❌ Nodes did not converge")
+		ps.feedback.Info(ctx, "💡 Reconnect them with 'benchy peers connect'")
+		return err
+	}
+	spinner.Success(fmt.Sprintf("✅ All nodes agree on block #%d", target))
+
+	headers := []string{"Node", "Group", "Head before heal", "Reorg depth"}
+	var rows [][]string
+	deepest := 0
+	for _, node := range nodeNames {
+		branch, found := branches[node]
+		if !found {
+			continue
+		}
+
+		depth, err := ps.reorgDepth(ctx, node, branch)
+		if err != nil {
+			rows = append(rows, []string{displayName(node), partition.Side(node), "-", "unavailable"})
+			continue
+		}
+		if depth > deepest {
+			deepest = depth
+		}
+
+		head := branch.from + uint64(len(branch.hashes)) - 1
+		rows = append(rows, []string{displayName(node), partition.Side(node), fmt.Sprintf("#%d", head), fmt.Sprintf("%d blocks", depth)})
+	}
+
+	if err := ps.feedback.DisplayTable(ctx, headers, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	if deepest == 0 {
+		ps.feedback.Success(ctx, "✅ No reorg: one side sealed no competing block")
+	} else {
+		ps.feedback.Warning(ctx, fmt.Sprintf("⚠️  Deepest reorg: %d blocks", deepest))
+	}
+	return nil
+}
+
+// waitForConvergence attend que tous les nodes aient le même bloc au numéro target
+func (ps *PartitionService) waitForConvergence(ctx context.Context, nodes []string, target uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, partitionHealTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		hashes := make(map[common.Hash]bool)
+		answered := 0
+		for _, node := range nodes {
+			if header, err := ps.ethClient.GetHeaderByNumber(ctx, nodeRPCURL(node), &target); err == nil {
+				hashes[header.Hash] = true
+				answered++
+			}
+		}
+		if answered == len(nodes) && len(hashes) == 1 {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("nodes did not agree on block #%d within %s", target, formatAge(partitionHealTimeout))
+		}
+	}
+}
+
+// reorgDepth compte les blocs de la branche d'un node qui ne sont plus dans sa chaîne, depuis la tête
+func (ps *PartitionService) reorgDepth(ctx context.Context, node string, branch nodeBranch) (int, error) {
+	depth := 0
+	for i := len(branch.hashes) - 1; i >= 0; i-- {
+		number := branch.from + uint64(i)
+		header, err := ps.ethClient.GetHeaderByNumber(ctx, nodeRPCURL(node), &number)
+		if err != nil {
+			return 0, err
+		}
+		if header.Hash == branch.hashes[i] {
+			break
+		}
+		depth++
+	}
+	return depth, nil
+}
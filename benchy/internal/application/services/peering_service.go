@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/infrastructure/docker"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/feedback"
+)
+
+const (
+	// peeringReadyTimeout borne l'attente des nodes fraîchement lancés avant qu'ils exposent admin_nodeInfo
+	peeringReadyTimeout = 60 * time.Second
+	// peeringSettleTimeout borne l'attente des handshakes devp2p après admin_addPeer
+	peeringSettleTimeout = 15 * time.Second
+)
+
+// PeeringService connecte les nodes entre eux : ils sont lancés avec --nodiscover
+// et ne se trouvent donc pas sans admin_addPeer
+type PeeringService struct {
+	dockerClient *docker.DockerClient
+	ethClient    *ethereum.EthereumClient
+	feedback     *feedback.ConsoleFeedback
+}
+
+// NewPeeringService crée un nouveau service de peering
+func NewPeeringService() (*PeeringService, error) {
+	fb := feedback.NewConsoleFeedback()
+	dockerClient, err := docker.NewDockerClient(fb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+
+	return &PeeringService{
+		dockerClient: dockerClient,
+		ethClient:    ethereum.NewEthereumClient(),
+		feedback:     fb,
+	}, nil
+}
+
+// ParseTopology interprète une topologie sur les nodes du réseau benchy
+func (ps *PeeringService) ParseTopology(value string) (*entities.Topology, error) {
+	return entities.ParseTopology(value, nodeNames)
+}
+
+// Connect relie les nodes selon la topologie : chaque lien est établi par un admin_addPeer
+// vers l'enode du voisin, réécrit avec son IP sur le réseau Docker
+func (ps *PeeringService) Connect(ctx context.Context, topology *entities.Topology) error {
+	ps.feedback.Info(ctx, fmt.Sprintf("🔗 Peering nodes (topology: %s, %d links)", topology, len(topology.Links)))
+	if len(topology.Links) == 0 {
+		ps.feedback.Warning(ctx, "⚠️  Topology has no links, nothing to connect")
+		return nil
+	}
+
+	// 1. Enode de chaque node concerné, en attendant que les nodes répondent
+	readyCtx, cancel := context.WithTimeout(ctx, peeringReadyTimeout)
+	defer cancel()
+
+	enodes := make(map[string]string)
+	for _, name := range nodeNames {
+		if len(topology.Neighbours(name)) == 0 {
+			continue
+		}
+
+		enode, err := ps.waitForEnode(readyCtx, name)
+		if err != nil {
+			ps.feedback.Warning(ctx, fmt.Sprintf("⚠️  %s: %v", displayName(name), err))
+			continue
+		}
+		enodes[name] = enode
+	}
+
+	// 2. Un admin_addPeer par lien
+	connected := 0
+	for _, link := range topology.Links {
+		target, exists := enodes[link.To]
+		if _, ready := enodes[link.From]; !ready || !exists {
+			ps.feedback.Warning(ctx, fmt.Sprintf("⏭️  %s ↔ %s skipped (node unavailable)", displayName(link.From), displayName(link.To)))
+			continue
+		}
+
+		if err := ps.ethClient.AddPeer(ctx, nodeAdminURL(link.From), target); err != nil {
+			ps.feedback.Error(ctx, fmt.Sprintf("❌ %s ↔ %s: %v", displayName(link.From), displayName(link.To), err))
+			continue
+		}
+		ps.feedback.Success(ctx, fmt.Sprintf("✅ %s ↔ %s", displayName(link.From), displayName(link.To)))
+		connected++
+	}
+
+	if connected == 0 {
+		return fmt.Errorf("no peer connection established")
+	}
+
+	// 3. Vérifier que les handshakes aboutissent
+	if !ps.waitForPeers(ctx, topology, enodes) {
+		ps.feedback.Warning(ctx, "⚠️  Some nodes have fewer peers than expected (genesis or network id mismatch?)")
+	}
+	return ps.List(ctx)
+}
+
+// List affiche l'enode et le nombre de peers de chaque node
+func (ps *PeeringService) List(ctx context.Context) error {
+	headers := []string{"Node", "Client", "Enode", "Peers"}
+	var rows [][]string
+
+	// Sans containers (daemon absent...), les enodes restent affichés avec l'IP annoncée par le node
+	containers, _ := nodeContainers(ctx, ps.dockerClient)
+
+	for _, name := range nodeNames {
+		info, err := ps.ethClient.GetNodeInfo(ctx, nodeAdminURL(name))
+		if err != nil {
+			rows = append(rows, []string{displayName(name), "-", "unavailable", "-"})
+			continue
+		}
+
+		enode := info.Enode
+		if container, found := containers[name]; found {
+			if ip, err := ps.dockerClient.GetContainerIP(ctx, container.ID, dockerNetworkName); err == nil {
+				if rewritten, err := ethereum.EnodeWithHost(info.Enode, ip); err == nil {
+					enode = rewritten
+				}
+			}
+		}
+
+		peers := "-"
+		if count, err := ps.ethClient.GetPeerCount(ctx, nodeRPCURL(name)); err == nil {
+			peers = fmt.Sprintf("%d", count)
+		}
+
+		rows = append(rows, []string{displayName(name), clientName(info.Name), shortEnode(enode), peers})
+	}
+
+	if err := ps.feedback.DisplayTable(ctx, headers, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	return nil
+}
+
+// waitForEnode attend que le node réponde à admin_nodeInfo et retourne son enode joignable depuis le réseau Docker
+func (ps *PeeringService) waitForEnode(ctx context.Context, name string) (string, error) {
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		info, err := ps.ethClient.GetNodeInfo(ctx, nodeAdminURL(name))
+		if err == nil {
+			container, err := nodeContainer(ctx, ps.dockerClient, name)
+			if err != nil {
+				return "", err
+			}
+			ip, err := ps.dockerClient.GetContainerIP(ctx, container.ID, dockerNetworkName)
+			if err != nil {
+				return "", err
+			}
+			return ethereum.EnodeWithHost(info.Enode, ip)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return "", fmt.Errorf("admin_nodeInfo not available: %w", err)
+		}
+	}
+}
+
+// waitForPeers attend que chaque node atteigne le nombre de voisins prévu par la topologie
+func (ps *PeeringService) waitForPeers(ctx context.Context, topology *entities.Topology, enodes map[string]string) bool {
+	ctx, cancel := context.WithTimeout(ctx, peeringSettleTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		settled := true
+		for name := range enodes {
+			count, err := ps.ethClient.GetPeerCount(ctx, nodeRPCURL(name))
+			if err != nil || count < len(topology.Neighbours(name)) {
+				settled = false
+				break
+			}
+		}
+		if settled {
+			return true
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// clientName extrait le client de la chaîne de version (Geth/v1.13.15-stable/... → Geth)
+func clientName(version string) string {
+	if version == "" {
+		return "-"
+	}
+	return strings.SplitN(version, "/", 2)[0]
+}
+
+// shortEnode abrège la clé publique d'un enode pour les tableaux
+func shortEnode(enode string) string {
+	at := strings.Index(enode, "@")
+	if !strings.HasPrefix(enode, "enode://") || at < len("enode://")+12 {
+		return enode
+	}
+	id := enode[len("enode://"):at]
+	return "enode://" + id[:8] + "…" + id[len(id)-4:] + enode[at:]
+}
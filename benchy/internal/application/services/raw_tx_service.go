@@ -0,0 +1,405 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/feedback"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RawTxRequest décrit une transaction à construire champ par champ ; un champ vide est complété
+// depuis le node (nonce, gas, frais, chain ID), sauf en mode hors ligne
+type RawTxRequest struct {
+	From      string
+	To        string
+	Value     string
+	Data      string
+	Nonce     string
+	Gas       string
+	GasPrice  string
+	GasTipCap string
+	GasFeeCap string
+	Type      string
+	ChainID   string
+	Node      string
+	Offline   bool
+}
+
+// RawTxService construit, signe et diffuse des transactions brutes, pour rejouer exactement une transaction
+type RawTxService struct {
+	baseDir   string
+	ethClient *ethereum.EthereumClient
+	feedback  *feedback.ConsoleFeedback
+}
+
+// NewRawTxService crée un nouveau service de transactions brutes
+func NewRawTxService(baseDir string) *RawTxService {
+	return &RawTxService{
+		baseDir:   baseDir,
+		ethClient: ethereum.NewEthereumClient(),
+		feedback:  feedback.NewConsoleFeedback(),
+	}
+}
+
+// Build construit une transaction non signée et affiche son encodage RLP et son hash de signature
+func (rs *RawTxService) Build(ctx context.Context, request RawTxRequest, output string) error {
+	tx, chainID, err := rs.build(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := ethereum.EncodeUnsignedTransaction(tx, chainID)
+	if err != nil {
+		return err
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	rs.displayTx(ctx, "🧾 Unsigned transaction", tx, chainID, nil)
+	rs.feedback.Info(ctx, "Signing hash: "+signer.Hash(tx).Hex())
+
+	if err := rs.output(ctx, encoded, output); err != nil {
+		return err
+	}
+	rs.feedback.Info(ctx, "💡 Sign it with: benchy tx sign <rlp> --from <account>")
+
+	return nil
+}
+
+// Sign signe une transaction non signée (RLP hexadécimal ou fichier) avec une clé benchy ;
+// sans transaction en entrée, la construit d'abord depuis la requête
+func (rs *RawTxService) Sign(ctx context.Context, input string, request RawTxRequest, output string) error {
+	var tx *types.Transaction
+	var chainID *big.Int
+	var err error
+
+	if input != "" {
+		data, err := readRawInput(input)
+		if err != nil {
+			return err
+		}
+		if tx, chainID, err = ethereum.DecodeUnsignedTransaction(data); err != nil {
+			return err
+		}
+	} else if tx, chainID, err = rs.build(ctx, request); err != nil {
+		return err
+	}
+
+	name := strings.ToLower(request.From)
+	key, err := loadAccountKey(ctx, rs.feedback, rs.baseDir, name)
+	if err != nil {
+		return fmt.Errorf("failed to load %s key: %w", name, err)
+	}
+
+	signedTx, err := ethereum.SignRawTransaction(tx, chainID, key.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := signedTx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode signed transaction: %w", err)
+	}
+
+	rs.displayTx(ctx, "🧾 Signed transaction", signedTx, chainID, &key.Address)
+	rs.feedback.Info(ctx, "Hash: "+signedTx.Hash().Hex())
+
+	if err := rs.output(ctx, encoded, output); err != nil {
+		return err
+	}
+	rs.feedback.Info(ctx, "💡 Broadcast it with: benchy tx broadcast <rlp> --node <node>")
+
+	return nil
+}
+
+// Broadcast diffuse une transaction signée (RLP hexadécimal ou fichier) sur un node
+func (rs *RawTxService) Broadcast(ctx context.Context, node string, input string) error {
+	name := strings.ToLower(node)
+	if _, exists := nodeRPCPorts[name]; !exists {
+		return fmt.Errorf("unknown node: %s", node)
+	}
+	nodeURL := nodeRPCURL(name)
+
+	data, err := readRawInput(input)
+	if err != nil {
+		return err
+	}
+
+	tx, from, err := ethereum.DecodeSignedTransaction(data)
+	if err != nil {
+		return err
+	}
+
+	if err := rs.ethClient.ConnectToNode(ctx, nodeURL); err != nil {
+		return fmt.Errorf("%s is not reachable: %w", name, err)
+	}
+
+	// Un chain ID différent est accepté : c'est parfois le cas à reproduire
+	if chainID, err := rs.ethClient.ChainID(ctx, nodeURL); err == nil && tx.Protected() && chainID.Cmp(tx.ChainId()) != 0 {
+		rs.feedback.Warning(ctx, fmt.Sprintf("⚠️  Transaction signed for chain %s, %s is on chain %s", tx.ChainId(), displayName(name), chainID))
+	}
+
+	rs.displayTx(ctx, "🧾 Transaction", tx, tx.ChainId(), &from)
+
+	txHash, err := rs.ethClient.SendRawTransaction(ctx, nodeURL, tx)
+	if err != nil {
+		return err
+	}
+
+	rs.feedback.Success(ctx, fmt.Sprintf("✅ Broadcast to %s: %s", displayName(name), txHash.Hex()))
+	rs.feedback.Info(ctx, fmt.Sprintf("💡 Follow it with: benchy tx %s --node %s", txHash.Hex(), name))
+
+	return nil
+}
+
+// build construit la transaction go-ethereum d'une requête et retourne son chain ID
+func (rs *RawTxService) build(ctx context.Context, request RawTxRequest) (*types.Transaction, *big.Int, error) {
+	dynamicFee, err := parseRawTxType(request.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txType := entities.TxTypeTransfer
+	var to common.Address
+	if request.To == "" {
+		txType = entities.TxTypeContract
+	} else if to, err = resolveAccountOrContract(rs.baseDir, request.To); err != nil {
+		return nil, nil, err
+	}
+
+	value, err := parseEther(request.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// L'émetteur ne sert qu'à interroger le node (nonce, estimation du gas)
+	var from common.Address
+	if request.From != "" && !request.Offline {
+		if from, err = resolveAddress(rs.baseDir, request.From); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	tx := entities.NewTransaction(from, to, value, txType)
+	if request.Data != "" {
+		if tx.Data, err = hexutil.Decode(request.Data); err != nil {
+			return nil, nil, fmt.Errorf("invalid data: %w", err)
+		}
+	}
+	if request.Gas != "" {
+		if tx.Gas, err = strconv.ParseUint(request.Gas, 0, 64); err != nil {
+			return nil, nil, fmt.Errorf("invalid gas limit: %s", request.Gas)
+		}
+	}
+
+	if err := setRawTxFees(tx, request, dynamicFee); err != nil {
+		return nil, nil, err
+	}
+
+	var chainID *big.Int
+	if request.ChainID != "" {
+		var ok bool
+		if chainID, ok = new(big.Int).SetString(request.ChainID, 0); !ok || chainID.Sign() <= 0 {
+			return nil, nil, fmt.Errorf("invalid chain ID: %s", request.ChainID)
+		}
+	}
+
+	if request.Nonce != "" {
+		if tx.Nonce, err = strconv.ParseUint(request.Nonce, 0, 64); err != nil {
+			return nil, nil, fmt.Errorf("invalid nonce: %s", request.Nonce)
+		}
+	}
+
+	if request.Offline {
+		if request.Nonce == "" || tx.Gas == 0 || chainID == nil {
+			return nil, nil, fmt.Errorf("--offline requires --nonce, --gas and --chain-id")
+		}
+		if (dynamicFee && (tx.GasTipCap == nil || tx.GasFeeCap == nil)) || (!dynamicFee && tx.GasPrice == nil) {
+			return nil, nil, fmt.Errorf("--offline requires the fees: --tip and --fee-cap, or --gas-price for a legacy transaction")
+		}
+		return ethereum.NewRawTransaction(tx, chainID), chainID, nil
+	}
+
+	if chainID, err = rs.fillFromNode(ctx, request, tx, dynamicFee, chainID); err != nil {
+		return nil, nil, err
+	}
+
+	return ethereum.NewRawTransaction(tx, chainID), chainID, nil
+}
+
+// fillFromNode complète depuis un node les champs absents (nonce de l'émetteur, frais, gas)
+// et retourne le chain ID demandé, ou celui du node
+func (rs *RawTxService) fillFromNode(ctx context.Context, request RawTxRequest, tx *entities.Transaction, dynamicFee bool, chainID *big.Int) (*big.Int, error) {
+	name := strings.ToLower(request.Node)
+	if _, exists := nodeRPCPorts[name]; !exists {
+		return nil, fmt.Errorf("unknown node: %s", request.Node)
+	}
+	nodeURL := nodeRPCURL(name)
+
+	if err := rs.ethClient.ConnectToNode(ctx, nodeURL); err != nil {
+		return nil, fmt.Errorf("%s is not reachable (use --offline to build without a node): %w", name, err)
+	}
+
+	if chainID == nil {
+		var err error
+		if chainID, err = rs.ethClient.ChainID(ctx, nodeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.From == "" && (request.Nonce == "" || tx.Gas == 0) {
+		return nil, fmt.Errorf("--from is required to fetch the nonce or estimate the gas")
+	}
+
+	if request.Nonce == "" {
+		nonce, err := rs.ethClient.GetNonce(ctx, nodeURL, tx.From)
+		if err != nil {
+			return nil, err
+		}
+		tx.Nonce = nonce
+	}
+
+	if !dynamicFee && tx.GasPrice == nil {
+		gasPrice, err := rs.ethClient.SuggestGasPrice(ctx, nodeURL)
+		if err != nil {
+			return nil, err
+		}
+		tx.GasPrice = gasPrice
+	}
+
+	if err := rs.ethClient.FillTransaction(ctx, nodeURL, tx); err != nil {
+		return nil, err
+	}
+
+	return chainID, nil
+}
+
+// displayTx affiche les champs d'une transaction brute
+func (rs *RawTxService) displayTx(ctx context.Context, title string, tx *types.Transaction, chainID *big.Int, from *common.Address) {
+	labels := loadAddressLabels(rs.baseDir)
+
+	fees := formatGwei(tx.GasPrice())
+	if tx.Type() == types.DynamicFeeTxType {
+		fees = fmt.Sprintf("tip %s / cap %s", formatGwei(tx.GasTipCap()), formatGwei(tx.GasFeeCap()))
+	}
+
+	input := "-"
+	if len(tx.Data()) >= 4 {
+		input = fmt.Sprintf("%d bytes (selector %s)", len(tx.Data()), hexutil.Encode(tx.Data()[:4]))
+	} else if len(tx.Data()) > 0 {
+		input = fmt.Sprintf("%d bytes", len(tx.Data()))
+	}
+
+	rows := [][]string{
+		{"Type", formatTxType(tx.Type())},
+		{"Chain ID", chainID.String()},
+	}
+	if from != nil {
+		rows = append(rows, []string{"From", labels.label(*from)})
+	}
+	rows = append(rows,
+		[]string{"To", labels.labelTo(tx.To())},
+		[]string{"Value", formatEther(tx.Value())},
+		[]string{"Nonce", fmt.Sprintf("%d", tx.Nonce())},
+		[]string{"Fees", fees},
+		[]string{"Gas limit", fmt.Sprintf("%d", tx.Gas())},
+		[]string{"Input", input},
+	)
+
+	rs.feedback.Info(ctx, title)
+	if err := rs.feedback.DisplayTable(ctx, []string{"Field", "Value"}, rows); err != nil {
+		rs.feedback.Warning(ctx, fmt.Sprintf("failed to display table: %v", err))
+	}
+}
+
+// output affiche l'encodage RLP d'une transaction, et l'écrit dans un fichier si demandé
+func (rs *RawTxService) output(ctx context.Context, encoded []byte, path string) error {
+	rlpHex := hexutil.Encode(encoded)
+	rs.feedback.Info(ctx, "RLP: "+rlpHex)
+
+	if path == "" {
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(rlpHex+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write transaction: %w", err)
+	}
+	rs.feedback.Success(ctx, "✅ Transaction written to "+path)
+
+	return nil
+}
+
+// setRawTxFees fixe les frais demandés selon le type : gas price (legacy) ou tip et fee cap (EIP-1559)
+func setRawTxFees(tx *entities.Transaction, request RawTxRequest, dynamicFee bool) error {
+	if !dynamicFee {
+		if request.GasTipCap != "" || request.GasFeeCap != "" {
+			return fmt.Errorf("--tip and --fee-cap only apply to EIP-1559 transactions: use --gas-price")
+		}
+		if request.GasPrice != "" {
+			gasPrice, err := parseGwei(request.GasPrice)
+			if err != nil {
+				return err
+			}
+			tx.GasPrice = gasPrice
+		}
+		return nil
+	}
+
+	if request.GasPrice != "" {
+		return fmt.Errorf("--gas-price only applies to legacy transactions: use --tip and --fee-cap, or --type legacy")
+	}
+	if request.GasTipCap != "" {
+		tip, err := parseGwei(request.GasTipCap)
+		if err != nil {
+			return err
+		}
+		tx.GasTipCap = tip
+	}
+	if request.GasFeeCap != "" {
+		feeCap, err := parseGwei(request.GasFeeCap)
+		if err != nil {
+			return err
+		}
+		tx.GasFeeCap = feeCap
+	}
+
+	return nil
+}
+
+// parseRawTxType indique si le type demandé est EIP-1559 (2) plutôt que legacy (0)
+func parseRawTxType(txType string) (bool, error) {
+	switch strings.ToLower(txType) {
+	case "", "2", "1559", "eip1559", "eip-1559", "dynamic":
+		return true, nil
+	case "0", "legacy":
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported transaction type %s (legacy or 1559)", txType)
+}
+
+// readRawInput lit une transaction RLP hexadécimale, directement ou depuis un fichier
+func readRawInput(input string) ([]byte, error) {
+	value := input
+	if !strings.HasPrefix(input, "0x") {
+		content, err := os.ReadFile(input)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read %s: %w", input, err)
+		}
+		if err == nil {
+			value = string(content)
+		}
+	}
+
+	data, err := hexutil.Decode(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("transaction is neither a 0x-prefixed RLP hex string nor a file containing one")
+	}
+	return data, nil
+}
@@ -4,50 +4,167 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/ethereum/contracts"
 	"benchy/internal/infrastructure/feedback"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// byInitialSupply est l'offre initiale du token BY, mintée pour Alice
+	byInitialSupply = 1000000
+	// byTransferAmount est le montant distribué à Driss et Elena
+	byTransferAmount = 1000
+	// txMinedTimeout borne l'attente du minage d'une transaction
+	txMinedTimeout = 60 * time.Second
+	// transferCount et transferInterval rythment les transferts du scénario 1
+	transferCount    = 3
+	transferInterval = 10 * time.Second
 )
 
 // ScenarioService gère l'exécution des scénarios de test
 type ScenarioService struct {
-	feedback *feedback.ConsoleFeedback
+	baseDir   string
+	ethClient ports.EthereumService
+	simulated bool // Chaîne en mémoire : rien n'est enregistré pour le réseau Docker
+	feedback  *feedback.ConsoleFeedback
 }
 
 // NewScenarioService crée un nouveau service de scénarios
-func NewScenarioService() *ScenarioService {
+func NewScenarioService(baseDir string) *ScenarioService {
 	return &ScenarioService{
-		feedback: feedback.NewConsoleFeedback(),
+		baseDir:   baseDir,
+		ethClient: ethereum.NewEthereumClient(),
+		feedback:  feedback.NewConsoleFeedback(),
 	}
 }
 
-// RunInitScenario exécute le scénario d'initialisation (Scénario 0)
+// SetFeePolicy choisit la politique de frais EIP-1559 des transactions envoyées par les scénarios
+func (ss *ScenarioService) SetFeePolicy(policy entities.FeePolicy) {
+	ss.ethClient.SetFeePolicy(policy)
+}
+
+// UseSimulatedBackend exécute les scénarios sur une chaîne en mémoire créée depuis le genesis du réseau
+// (mêmes comptes et allocations, blocs scellés à la période Clique), sans node ni Docker
+func (ss *ScenarioService) UseSimulatedBackend(ctx context.Context) error {
+	genesis, err := config.LoadGenesis(ss.baseDir)
+	if err != nil {
+		return err
+	}
+
+	period := time.Duration(0)
+	if genesis.Config != nil && genesis.Config.Clique != nil {
+		period = time.Duration(genesis.Config.Clique.Period) * time.Second
+	}
+
+	client, err := ethereum.NewSimulatedClient(genesis, period)
+	if err != nil {
+		return err
+	}
+
+	ss.ethClient = client
+	ss.simulated = true
+	ss.feedback.Info(ctx, fmt.Sprintf("🧪 Simulated in-process chain (chain ID %s, %s blocks)", genesis.Config.ChainID, period))
+
+	return nil
+}
+
+// RunInitScenario exécute le scénario d'initialisation (Scénario 0) : chaque node répond, les validateurs
+// du genesis sont financés et forment l'ensemble Clique, et la chaîne scelle de nouveaux blocs
 func (ss *ScenarioService) RunInitScenario(ctx context.Context) error {
 	ss.feedback.Info(ctx, "🚀 Running Scenario 0: Network Initialization")
 
-	// 1. Vérifier que les 5 nodes sont connectés
+	// 1. Vérifier que les nodes répondent (et qu'ils sont pairés, hors chaîne simulée)
 	spinner, err := ss.feedback.StartSpinner(ctx, "Checking network connectivity...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ All 5 nodes are connected")
+	var unreachable, isolated []string
+	var head uint64
+	for _, name := range nodeNames {
+		nodeURL := nodeRPCURL(name)
+		if err := ss.ethClient.ConnectToNode(ctx, nodeURL); err != nil {
+			unreachable = append(unreachable, name)
+			continue
+		}
+		number, err := ss.ethClient.GetLatestBlockNumber(ctx, nodeURL)
+		if err != nil {
+			unreachable = append(unreachable, name)
+			continue
+		}
+		if number > head {
+			head = number
+		}
+		if peers, err := ss.ethClient.GetPeerCount(ctx, nodeURL); !ss.simulated && (err != nil || peers == 0) {
+			isolated = append(isolated, name)
+		}
+	}
+	if len(unreachable) > 0 {
+		spinner.Error(fmt.Sprintf("❌ Unreachable nodes: %s", strings.Join(unreachable, ", ")))
+		return fmt.Errorf("%d of %d nodes are unreachable: launch the network first", len(unreachable), len(nodeNames))
+	}
+	spinner.Success(fmt.Sprintf("✅ All %d nodes are connected (head: block #%d)", len(nodeNames), head))
+	if len(isolated) > 0 {
+		ss.feedback.Warning(ctx, fmt.Sprintf("⚠️  No peers on %s", strings.Join(isolated, ", ")))
+	}
 
-	// 2. Vérifier les balances initiales
+	// 2. Vérifier les balances initiales des validateurs
+	aliceURL := nodeRPCURL("alice")
+	addresses := loadNodeAddresses(ss.baseDir)
 	spinner, err = ss.feedback.StartSpinner(ctx, "Checking initial ETH balances...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ Alice, Bob, Cassandra have 1000 ETH each")
+	var balances []string
+	for _, name := range nodeNames {
+		if !validatorNodes[name] {
+			continue
+		}
+		address, found := addresses[name]
+		if !found {
+			spinner.Error(fmt.Sprintf("❌ No keystore for %s", name))
+			return fmt.Errorf("%s has no keystore: launch the network first", name)
+		}
+		balance, err := ss.ethClient.GetBalance(ctx, aliceURL, address)
+		if err != nil {
+			spinner.Error("❌ Failed to read balances")
+			return fmt.Errorf("failed to get %s balance: %w", name, err)
+		}
+		if balance.Sign() == 0 {
+			spinner.Error(fmt.Sprintf("❌ %s has no ETH", displayName(name)))
+			return fmt.Errorf("%s was not funded by the genesis", name)
+		}
+		balances = append(balances, fmt.Sprintf("%s: %s", displayName(name), formatEther(balance)))
+	}
+	spinner.Success(fmt.Sprintf("✅ %s", strings.Join(balances, ", ")))
 
-	// 3. Vérifier le consensus Clique
+	// 3. Vérifier l'ensemble Clique et la production de blocs
 	spinner, err = ss.feedback.StartSpinner(ctx, "Verifying Clique consensus...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ Clique consensus active with 3 validators")
+	signers, err := ss.ethClient.GetSigners(ctx, aliceURL)
+	if err != nil {
+		spinner.Error("❌ Failed to read the Clique signers")
+		return fmt.Errorf("failed to get signers: %w", err)
+	}
+	var validators []string
+	for _, signer := range signers {
+		validators = append(validators, displayName(nodeNameByAddress(addresses, signer)))
+	}
+	block, err := ss.waitForNewBlock(ctx, "alice", head)
+	if err != nil {
+		spinner.Error("❌ No new block sealed")
+		return err
+	}
+	spinner.Success(fmt.Sprintf("✅ Clique consensus active with %d validators (%s), block #%d sealed", len(signers), strings.Join(validators, ", "), block))
 
 	ss.feedback.Success(ctx, "🎉 Scenario 0 completed successfully!")
 	ss.feedback.Info(ctx, "💡 Network is properly initialized and ready for testing")
@@ -55,135 +172,473 @@ func (ss *ScenarioService) RunInitScenario(ctx context.Context) error {
 	return nil
 }
 
-// RunTransferScenario exécute le scénario de transferts (Scénario 1)
+// waitForNewBlock attend qu'un bloc postérieur à after soit scellé sur un node, au plus txMinedTimeout. Il
+// suit l'abonnement newHeads du node ; sans WebSocket, ou si l'abonnement tombe, il interroge le node
+// chaque seconde.
+func (ss *ScenarioService) waitForNewBlock(ctx context.Context, node string, after uint64) (uint64, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, txMinedTimeout)
+	defer cancel()
+
+	nodeURL := nodeRPCURL(node)
+	latest := func() (uint64, bool) {
+		number, err := ss.ethClient.GetLatestBlockNumber(waitCtx, nodeURL)
+		return number, err == nil && number > after
+	}
+
+	poll := time.NewTicker(time.Second)
+	defer poll.Stop()
+	heads := make(chan *ports.BlockHeader, 16)
+	var subErr <-chan error
+	if sub, err := ss.ethClient.SubscribeNewHeads(waitCtx, nodeWSURL(node), heads); err == nil {
+		defer sub.Unsubscribe()
+		subErr = sub.Err()
+		poll.Stop()
+	}
+
+	// Un bloc scellé avant l'abonnement n'arrive pas sur heads
+	if number, sealed := latest(); sealed {
+		return number, nil
+	}
+	for {
+		select {
+		case header := <-heads:
+			if header.Number > after {
+				return header.Number, nil
+			}
+		case <-poll.C:
+			if number, sealed := latest(); sealed {
+				return number, nil
+			}
+		case <-subErr:
+			// Abonnement perdu : retour au polling
+			subErr = nil
+			poll.Reset(time.Second)
+		case <-waitCtx.Done():
+			return 0, fmt.Errorf("no block sealed after #%d within %s: %w", after, txMinedTimeout, waitCtx.Err())
+		}
+	}
+}
+
+// RunTransferScenario exécute le scénario de transferts (Scénario 1) : Alice envoie 0.1 ETH à Bob
+// toutes les transferInterval, chaque transfert étant signé localement et attendu jusqu'à son minage
 func (ss *ScenarioService) RunTransferScenario(ctx context.Context) error {
 	ss.feedback.Info(ctx, "💸 Running Scenario 1: ETH Transfers")
 
-	// 1. Vérifier les balances avant transfert
+	aliceURL := nodeRPCURL("alice")
+	if err := ss.ethClient.ConnectToNode(ctx, aliceURL); err != nil {
+		return fmt.Errorf("alice is not reachable: %w", err)
+	}
+
+	aliceKey, err := loadAccountKey(ctx, ss.feedback, ss.baseDir, "alice")
+	if err != nil {
+		return fmt.Errorf("failed to load alice key: %w", err)
+	}
+	alice := ss.ethClient.AddAccount(aliceKey.PrivateKey)
+
+	bob, err := config.LoadAddressFromFile(config.NodeKeystoreDir(ss.baseDir, "bob"), "bob")
+	if err != nil {
+		return fmt.Errorf("failed to load bob address: %w", err)
+	}
+
+	// 1. Balances avant les transferts
 	spinner, err := ss.feedback.StartSpinner(ctx, "Checking current balances...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(1 * time.Second)
-	spinner.Success("✅ Alice: 1000 ETH, Bob: 1000 ETH")
-
-	// 2. Effectuer le transfert Alice → Bob
-	spinner, err = ss.feedback.StartSpinner(ctx, "Sending 10 ETH from Alice to Bob...")
+	balances, err := ss.describeBalances(ctx, aliceURL, alice, bob)
 	if err != nil {
+		spinner.Error("❌ Balance check failed")
 		return err
 	}
-	time.Sleep(3 * time.Second)
-	spinner.Success("✅ Transaction mined in block #1235")
+	spinner.Success("✅ " + balances)
+
+	// 2. Transferts Alice → Bob, suivis jusqu'à leur confirmation
+	tracker, stopTracker := ss.newTracker(ctx, "alice")
+	defer stopTracker()
+	var transfers []*entities.Transaction
+	amount := new(big.Int).Div(big.NewInt(params.Ether), big.NewInt(10))
+	for i := 1; i <= transferCount; i++ {
+		if i > 1 {
+			select {
+			case <-time.After(transferInterval):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		spinner, err = ss.feedback.StartSpinner(ctx, fmt.Sprintf("Transfer #%d: sending %s from Alice to Bob...", i, formatEther(amount)))
+		if err != nil {
+			return err
+		}
+
+		tx := entities.NewTransaction(alice, bob, amount, entities.TxTypeTransfer)
+		txHash, err := ss.ethClient.SendTransaction(ctx, aliceURL, tx)
+		if err != nil {
+			spinner.Error(fmt.Sprintf("❌ Transfer #%d failed", i))
+			return err
+		}
+
+		if _, err := waitForConfirmation(ctx, ss.ethClient, tracker, aliceURL, tx); err != nil {
+			spinner.Error(fmt.Sprintf("❌ Transfer #%d failed", i))
+			return fmt.Errorf("transfer %s: %w", txHash.Hex(), err)
+		}
+		transfers = append(transfers, tx)
+		spinner.Success(fmt.Sprintf("✅ Transfer #%d mined in block #%d (nonce %d, confirmed in %s)",
+			i, tx.BlockNumber, tx.Nonce, formatLatency(tx.ConfirmationTime)))
+	}
 
-	// 3. Vérifier les nouvelles balances
+	// 3. Balances après les transferts
 	spinner, err = ss.feedback.StartSpinner(ctx, "Verifying new balances...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ Alice: 989.99 ETH, Bob: 1010 ETH")
+	balances, err = ss.describeBalances(ctx, aliceURL, alice, bob)
+	if err != nil {
+		spinner.Error("❌ Balance check failed")
+		return err
+	}
+	spinner.Success("✅ " + balances)
 
+	ss.reportLatencies(ctx, transfers)
 	ss.feedback.Success(ctx, "🎉 Scenario 1 completed successfully!")
 	ss.feedback.Info(ctx, "💡 ETH transfers are working correctly")
 
 	return nil
 }
 
+// describeBalances retourne les balances d'Alice et Bob sous la forme "Alice: 1000 ETH, Bob: 1000 ETH"
+func (ss *ScenarioService) describeBalances(ctx context.Context, nodeURL string, alice, bob common.Address) (string, error) {
+	var parts []string
+	for _, account := range []struct {
+		name    string
+		address common.Address
+	}{{"Alice", alice}, {"Bob", bob}} {
+		balance, err := ss.ethClient.GetBalance(ctx, nodeURL, account.address)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", account.name, formatEther(balance)))
+	}
+	return strings.Join(parts, ", "), nil
+}
+
 // RunERC20Scenario exécute le scénario ERC20 (Scénario 2)
 func (ss *ScenarioService) RunERC20Scenario(ctx context.Context) error {
 	ss.feedback.Info(ctx, "🪙 Running Scenario 2: ERC20 Token Operations")
 
+	aliceURL := nodeRPCURL("alice")
+	if err := ss.ethClient.ConnectToNode(ctx, aliceURL); err != nil {
+		return fmt.Errorf("alice is not reachable: %w", err)
+	}
+
+	// Alice déploie et signe localement avec sa clé
+	aliceKey, err := loadAccountKey(ctx, ss.feedback, ss.baseDir, "alice")
+	if err != nil {
+		return fmt.Errorf("failed to load alice key: %w", err)
+	}
+	alice := ss.ethClient.AddAccount(aliceKey.PrivateKey)
+
 	// 1. Déployer le contrat ERC20 BY
 	spinner, err := ss.feedback.StartSpinner(ctx, "Deploying BY token contract...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(3 * time.Second)
-	contractAddress := "0x1234567890123456789012345678901234567890"
-	spinner.Success(fmt.Sprintf("✅ BY contract deployed at %s", contractAddress))
 
-	// 2. Distribuer les tokens à Driss
-	spinner, err = ss.feedback.StartSpinner(ctx, "Sending 1000 BY tokens to Driss...")
+	deployCode, err := contracts.BYTokenDeployCode(contracts.ToTokenUnits(byInitialSupply))
 	if err != nil {
+		spinner.Error("❌ Failed to build BY deployment")
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ 1000 BY tokens sent to Driss")
 
-	// 3. Distribuer les tokens à Elena
-	spinner, err = ss.feedback.StartSpinner(ctx, "Sending 1000 BY tokens to Elena...")
+	tracker, stopTracker := ss.newTracker(ctx, "alice")
+	defer stopTracker()
+	var sent []*entities.Transaction
+
+	sentAt := time.Now()
+	contractAddress, txHash, err := ss.ethClient.DeployContract(ctx, aliceURL, deployCode, alice)
 	if err != nil {
+		spinner.Error("❌ BY deployment failed")
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ 1000 BY tokens sent to Elena")
 
-	// 4. Vérifier les balances de tokens
+	deployment, err := sentTransaction(ctx, ss.ethClient, aliceURL, txHash, entities.TxTypeContract, sentAt)
+	if err == nil {
+		_, err = waitForConfirmation(ctx, ss.ethClient, tracker, aliceURL, deployment)
+	}
+	if err != nil {
+		spinner.Error("❌ BY deployment failed")
+		return fmt.Errorf("BY deployment %s: %w", txHash.Hex(), err)
+	}
+	sent = append(sent, deployment)
+	spinner.Success(fmt.Sprintf("✅ BY contract deployed at %s (confirmed in %s)", contractAddress.Hex(), formatLatency(deployment.ConfirmationTime)))
+
+	// Enregistrer l'adresse pour les prochains `benchy infos` (le contrat simulé disparaît avec le processus)
+	if !ss.simulated {
+		registry, err := config.LoadTokenRegistry(ss.baseDir)
+		if err != nil {
+			return err
+		}
+		registry.Register(contracts.BYTokenSymbol, contractAddress)
+		if err := registry.Save(); err != nil {
+			return err
+		}
+	}
+
+	// 2. Distribuer les tokens à Driss et Elena
+	recipients := []string{"driss", "elena"}
+	for _, name := range recipients {
+		recipient, err := config.LoadAddressFromFile(config.NodeKeystoreDir(ss.baseDir, name), name)
+		if err != nil {
+			return fmt.Errorf("failed to load %s address: %w", name, err)
+		}
+
+		spinner, err = ss.feedback.StartSpinner(ctx, fmt.Sprintf("Sending %d BY tokens to %s...", byTransferAmount, displayName(name)))
+		if err != nil {
+			return err
+		}
+
+		sentAt := time.Now()
+		txHash, err := ss.ethClient.TransferToken(ctx, aliceURL, contractAddress, alice, recipient, contracts.ToTokenUnits(byTransferAmount))
+		if err != nil {
+			spinner.Error(fmt.Sprintf("❌ Transfer to %s failed", displayName(name)))
+			return err
+		}
+
+		transfer, err := sentTransaction(ctx, ss.ethClient, aliceURL, txHash, entities.TxTypeERC20, sentAt)
+		var receipt *ports.TransactionReceipt
+		if err == nil {
+			receipt, err = waitForConfirmation(ctx, ss.ethClient, tracker, aliceURL, transfer)
+		}
+		if err != nil {
+			spinner.Error(fmt.Sprintf("❌ Transfer to %s failed", displayName(name)))
+			return fmt.Errorf("BY transfer %s: %w", txHash.Hex(), err)
+		}
+		sent = append(sent, transfer)
+
+		// Le montant reçu est lu depuis l'événement Transfer émis par le contrat
+		received := receivedTokens(receipt, contractAddress, recipient)
+		if received.Cmp(contracts.ToTokenUnits(byTransferAmount)) != 0 {
+			spinner.Error(fmt.Sprintf("❌ %s received %.2f BY", displayName(name), contracts.FromTokenUnits(received)))
+			return fmt.Errorf("unexpected Transfer event for %s", name)
+		}
+		spinner.Success(fmt.Sprintf("✅ %s received %.0f BY (block #%d, confirmed in %s)",
+			displayName(name), contracts.FromTokenUnits(received), receipt.BlockNumber, formatLatency(transfer.ConfirmationTime)))
+	}
+
+	// 3. Vérifier les balances de tokens
 	spinner, err = ss.feedback.StartSpinner(ctx, "Verifying token balances...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(1 * time.Second)
-	spinner.Success("✅ Driss: 1000 BY, Elena: 1000 BY")
 
+	var balances []string
+	for _, name := range recipients {
+		holder, err := config.LoadAddressFromFile(config.NodeKeystoreDir(ss.baseDir, name), name)
+		if err != nil {
+			spinner.Error("❌ Balance check failed")
+			return err
+		}
+
+		balance, err := ss.ethClient.GetTokenBalance(ctx, aliceURL, contractAddress, holder)
+		if err != nil {
+			spinner.Error("❌ Balance check failed")
+			return err
+		}
+		if balance.Cmp(contracts.ToTokenUnits(byTransferAmount)) < 0 {
+			spinner.Error(fmt.Sprintf("❌ %s only has %.2f BY", displayName(name), contracts.FromTokenUnits(balance)))
+			return fmt.Errorf("unexpected BY balance for %s", name)
+		}
+
+		balances = append(balances, fmt.Sprintf("%s: %.0f BY", displayName(name), contracts.FromTokenUnits(balance)))
+	}
+	spinner.Success("✅ " + strings.Join(balances, ", "))
+
+	ss.reportLatencies(ctx, sent)
 	ss.feedback.Success(ctx, "🎉 Scenario 2 completed successfully!")
 	ss.feedback.Info(ctx, "💡 ERC20 token operations are working correctly")
 
 	return nil
 }
 
-// RunReplacementScenario exécute le scénario de remplacement (Scénario 3)
-func (ss *ScenarioService) RunReplacementScenario(ctx context.Context) error {
-	ss.feedback.Info(ctx, "🔄 Running Scenario 3: Validator Replacement")
+// newTracker crée le tracker qui suit les transactions d'un scénario sur un node. Il est réveillé par
+// chaque nouveau bloc du node (abonnement newHeads) ; sans WebSocket, il interroge le node chaque seconde.
+// stop met fin à l'abonnement.
+func (ss *ScenarioService) newTracker(ctx context.Context, node string) (tracker *ethereum.TransactionTracker, stop func()) {
+	tracker = ethereum.NewTransactionTracker(ss.ethClient, nodeRPCURL(node), ethereum.DefaultConfirmations)
+
+	followCtx, cancel := context.WithCancel(ctx)
+	heads := make(chan *ports.BlockHeader, 16)
+	sub, err := ss.ethClient.SubscribeNewHeads(followCtx, nodeWSURL(node), heads)
+	if err != nil {
+		cancel()
+		ss.feedback.Warning(ctx, fmt.Sprintf("⚠️  No newHeads subscription on %s, polling receipts instead: %v", node, err))
+		return tracker, func() {}
+	}
+	tracker.FollowHeads(followCtx, heads)
+
+	go func() {
+		if err, lost := <-sub.Err(); lost && err != nil {
+			ss.feedback.Warning(ctx, fmt.Sprintf("⚠️  newHeads subscription on %s ended, receipts are now polled slowly: %v", node, err))
+		}
+	}()
+
+	return tracker, func() {
+		sub.Unsubscribe()
+		cancel()
+	}
+}
 
-	// 1. État initial des validateurs
-	spinner, err := ss.feedback.StartSpinner(ctx, "Checking current validators...")
+// sentTransaction reconstruit, nonce compris, l'entité d'une transaction connue par son hash pour la
+// suivre ; sentAt, l'instant de l'envoi, est l'origine de sa latence de confirmation
+func sentTransaction(ctx context.Context, ethClient ports.EthereumService, nodeURL string, txHash common.Hash, txType entities.TransactionType, sentAt time.Time) (*entities.Transaction, error) {
+	info, err := ethClient.GetTransaction(ctx, nodeURL, txHash)
 	if err != nil {
+		return nil, err
+	}
+
+	tx := entities.NewTransaction(info.From, common.Address{}, info.Value, txType)
+	if info.To != nil {
+		tx.To = *info.To
+	}
+	tx.Hash = txHash
+	tx.Nonce = info.Nonce
+	tx.CreatedAt = sentAt
+
+	return tx, nil
+}
+
+// waitForConfirmation suit une transaction jusqu'à sa confirmation et retourne son reçu ; une transaction
+// échouée ou remplacée est une erreur
+func waitForConfirmation(ctx context.Context, ethClient ports.EthereumService, tracker *ethereum.TransactionTracker, nodeURL string, tx *entities.Transaction) (*ports.TransactionReceipt, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, txMinedTimeout)
+	defer cancel()
+
+	if _, err := tracker.WaitForConfirmation(waitCtx, tx); err != nil {
+		return nil, fmt.Errorf("transaction not mined: %w", err)
+	}
+
+	switch tx.Status {
+	case entities.TxStatusFailed:
+		return nil, fmt.Errorf("transaction reverted")
+	case entities.TxStatusReplaced:
+		return nil, fmt.Errorf("transaction replaced by another one with nonce %d", tx.Nonce)
+	}
+
+	return ethClient.GetTransactionReceipt(ctx, nodeURL, tx.Hash)
+}
+
+// reportLatencies affiche la latence de confirmation (envoi → confirmation) des transactions du scénario
+func (ss *ScenarioService) reportLatencies(ctx context.Context, txs []*entities.Transaction) {
+	if len(txs) == 0 {
+		return
+	}
+
+	var total, fastest, slowest time.Duration
+	for i, tx := range txs {
+		total += tx.ConfirmationTime
+		if i == 0 || tx.ConfirmationTime < fastest {
+			fastest = tx.ConfirmationTime
+		}
+		if tx.ConfirmationTime > slowest {
+			slowest = tx.ConfirmationTime
+		}
+	}
+
+	ss.feedback.Info(ctx, fmt.Sprintf("⏱️  Confirmation latency over %d transactions: min %s, avg %s, max %s",
+		len(txs), formatLatency(fastest), formatLatency(total/time.Duration(len(txs))), formatLatency(slowest)))
+}
+
+// receivedTokens additionne les événements Transfer d'un token vers un destinataire
+func receivedTokens(receipt *ports.TransactionReceipt, token, recipient common.Address) *big.Int {
+	total := new(big.Int)
+	for _, event := range receipt.EventsNamed("Transfer") {
+		if event.Address != token {
+			continue
+		}
+		to, _ := event.Args["to"].(common.Address)
+		value, _ := event.Args["value"].(*big.Int)
+		if to == recipient && value != nil {
+			total.Add(total, value)
+		}
+	}
+	return total
+}
+
+// RunTransactionReplacementScenario envoie une transaction puis la remplace par un speed-up (Scénario 3)
+func (ss *ScenarioService) RunTransactionReplacementScenario(ctx context.Context) error {
+	ss.feedback.Info(ctx, "🔄 Running Scenario 3: Transaction Replacement")
+
+	cassandraURL := nodeRPCURL("cassandra")
+	if err := ss.ethClient.ConnectToNode(ctx, cassandraURL); err != nil {
+		return fmt.Errorf("cassandra is not reachable: %w", err)
+	}
+
+	cassandraKey, err := loadAccountKey(ctx, ss.feedback, ss.baseDir, "cassandra")
+	if err != nil {
+		return fmt.Errorf("failed to load cassandra key: %w", err)
+	}
+	cassandra := ss.ethClient.AddAccount(cassandraKey.PrivateKey)
+
+	driss, err := config.LoadAddressFromFile(config.NodeKeystoreDir(ss.baseDir, "driss"), "driss")
+	if err != nil {
+		return fmt.Errorf("failed to load driss address: %w", err)
+	}
+
+	// 1. Transaction initiale, remplacée avant le prochain bloc Clique
+	spinner, err := ss.feedback.StartSpinner(ctx, "Sending 1 ETH from Cassandra to Driss...")
+	if err != nil {
+		return err
+	}
+
+	original := entities.NewTransaction(cassandra, driss, big.NewInt(params.Ether), entities.TxTypeTransfer)
+	if _, err := ss.ethClient.SendTransaction(ctx, cassandraURL, original); err != nil {
+		spinner.Error("❌ Initial transaction failed")
 		return err
 	}
-	time.Sleep(1 * time.Second)
-	spinner.Success("✅ Current validators: Alice, Bob, Cassandra")
+	spinner.Success(fmt.Sprintf("✅ Sent %s (nonce %d, tip %s wei)", original.Hash.Hex(), original.Nonce, original.GasTipCap))
 
-	// 2. Transférer 1 ETH d'Alice à Elena
-	spinner, err = ss.feedback.StartSpinner(ctx, "Sending 1 ETH from Alice to Elena...")
+	// 2. Remplacement : même nonce, frais augmentés
+	spinner, err = ss.feedback.StartSpinner(ctx, "Replacing it with a higher fee (speed-up)...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(3 * time.Second)
-	spinner.Success("✅ 1 ETH transferred to Elena")
 
-	// 3. Proposer Elena comme nouveau validateur
-	spinner, err = ss.feedback.StartSpinner(ctx, "Proposing Elena as new validator...")
+	replacement, err := ss.ethClient.ReplaceTransaction(ctx, cassandraURL, original, entities.ReplaceSpeedUp)
 	if err != nil {
+		spinner.Error("❌ Replacement rejected")
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ Elena proposed as validator")
+	spinner.Success(fmt.Sprintf("✅ Replacement %s (tip %s wei)", replacement.Hash.Hex(), replacement.GasTipCap))
 
-	// 4. Vérifier le changement
-	spinner, err = ss.feedback.StartSpinner(ctx, "Verifying validator set...")
+	// 3. Laquelle des deux a été minée ?
+	spinner, err = ss.feedback.StartSpinner(ctx, "Waiting for one of them to be mined...")
 	if err != nil {
 		return err
 	}
-	time.Sleep(2 * time.Second)
-	spinner.Success("✅ Elena balance updated: 1001 ETH")
 
-	ss.feedback.Success(ctx, "🎉 Scenario 3 completed successfully!")
-	ss.feedback.Info(ctx, "💡 Validator replacement mechanism is working")
+	waitCtx, cancel := context.WithTimeout(ctx, txMinedTimeout)
+	defer cancel()
 
-	return nil
-}
+	tracker, stopTracker := ss.newTracker(ctx, "cassandra")
+	defer stopTracker()
 
-// checkRPCConnection vérifie la connexion RPC à un node
-func (ss *ScenarioService) checkRPCConnection(ctx context.Context, nodeName string, port int) error {
-	ss.feedback.Info(ctx, fmt.Sprintf("✅ %s RPC connection verified (port %d)", nodeName, port))
-	return nil
-}
+	mined, err := tracker.WaitForReplacement(waitCtx, original, replacement)
+	if err != nil {
+		spinner.Error("❌ Neither transaction was mined")
+		return err
+	}
+
+	if mined == replacement {
+		spinner.Success(fmt.Sprintf("✅ Replacement mined in block #%d, original marked %s", mined.BlockNumber, original.Status))
+	} else {
+		spinner.Success(fmt.Sprintf("⚠️  Original mined in block #%d before the replacement", mined.BlockNumber))
+	}
+	if mined.IsConfirmed() {
+		ss.feedback.Info(ctx, fmt.Sprintf("⏱️  Confirmation latency of the mined transaction: %s", formatLatency(mined.ConfirmationTime)))
+	}
 
-// getBalance récupère la balance d'une adresse
-func (ss *ScenarioService) getBalance(ctx context.Context, address string) (*big.Int, error) {
-	// 1000 ETH en wei avec string pour éviter l'overflow
-	balance := new(big.Int)
-	balance.SetString("1000000000000000000000", 10)
-	return balance, nil
+	ss.feedback.Success(ctx, "🎉 Scenario 3 completed successfully!")
+	ss.feedback.Info(ctx, "💡 Transaction replacement is working correctly")
+
+	return nil
 }
@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/feedback"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidatorService gère la gouvernance Clique : liste des signers et votes
+type ValidatorService struct {
+	baseDir   string
+	ethClient *ethereum.EthereumClient
+	feedback  *feedback.ConsoleFeedback
+}
+
+// NewValidatorService crée un nouveau service de validateurs
+func NewValidatorService(baseDir string) *ValidatorService {
+	return &ValidatorService{
+		baseDir:   baseDir,
+		ethClient: ethereum.NewEthereumClient(),
+		feedback:  feedback.NewConsoleFeedback(),
+	}
+}
+
+// List affiche les validateurs actuels, les derniers blocs signés et les votes en cours
+func (vs *ValidatorService) List(ctx context.Context) error {
+	addresses := loadNodeAddresses(vs.baseDir)
+
+	nodeName, snapshot, err := vs.getSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Dernier bloc signé par chaque signer
+	lastSigned := make(map[common.Address]uint64)
+	for block, signer := range snapshot.Recents {
+		if block > lastSigned[signer] {
+			lastSigned[signer] = block
+		}
+	}
+
+	headers := []string{"Validator", "Address", "Last Signed Block"}
+	var rows [][]string
+	for _, signer := range snapshot.Signers {
+		last := "-"
+		if block, exists := lastSigned[signer]; exists {
+			last = fmt.Sprintf("%d", block)
+		}
+		rows = append(rows, []string{displayName(nodeNameByAddress(addresses, signer)), signer.Hex(), last})
+	}
+
+	vs.feedback.Info(ctx, fmt.Sprintf("🗳️  Clique signers at block #%d (seen by %s)", snapshot.Number, displayName(nodeName)))
+	if err := vs.feedback.DisplayTable(ctx, headers, rows); err != nil {
+		return fmt.Errorf("failed to display table: %w", err)
+	}
+
+	if len(snapshot.Tally) == 0 {
+		vs.feedback.Info(ctx, "💡 No pending votes")
+		return nil
+	}
+
+	// Votes en cours : une majorité stricte des signers est requise
+	needed := len(snapshot.Signers)/2 + 1
+	vs.feedback.Info(ctx, "📋 Pending votes:")
+	for address, tally := range snapshot.Tally {
+		action := "add"
+		if !tally.Authorize {
+			action = "remove"
+		}
+
+		var voters []string
+		for _, vote := range snapshot.Votes {
+			if vote.Address == address {
+				voters = append(voters, displayName(nodeNameByAddress(addresses, vote.Signer)))
+			}
+		}
+
+		vs.feedback.Info(ctx, fmt.Sprintf("   • %s %s: %d/%d votes (%s)",
+			action, displayName(nodeNameByAddress(addresses, address)), tally.Votes, needed, strings.Join(voters, ", ")))
+	}
+
+	return nil
+}
+
+// Propose fait voter les signers pour ajouter (authorize) ou retirer un validateur.
+// Sans votants explicites, tous les signers actuels gérés par benchy votent.
+func (vs *ValidatorService) Propose(ctx context.Context, target string, authorize bool, voters []string) error {
+	address, err := resolveAddress(vs.baseDir, target)
+	if err != nil {
+		return err
+	}
+
+	action := "add"
+	if !authorize {
+		action = "remove"
+	}
+
+	voters, err = vs.resolveVoters(ctx, voters)
+	if err != nil {
+		return err
+	}
+
+	vs.feedback.Info(ctx, fmt.Sprintf("🗳️  Proposing to %s %s (%s)", action, displayName(target), address.Hex()))
+
+	failures := 0
+	for _, voter := range voters {
+		if err := vs.ethClient.Propose(ctx, nodeRPCURL(voter), address, authorize); err != nil {
+			vs.feedback.Error(ctx, fmt.Sprintf("❌ %s: %v", displayName(voter), err))
+			failures++
+			continue
+		}
+		vs.feedback.Success(ctx, fmt.Sprintf("✅ %s votes to %s %s", displayName(voter), action, displayName(target)))
+	}
+
+	if failures == len(voters) {
+		return fmt.Errorf("no signer accepted the proposal")
+	}
+
+	vs.feedback.Info(ctx, "💡 Votes are cast in the blocks each signer seals; follow with 'benchy validators watch'")
+	return nil
+}
+
+// Discard retire la proposition en cours pour une adresse sur les nodes votants
+func (vs *ValidatorService) Discard(ctx context.Context, target string, voters []string) error {
+	address, err := resolveAddress(vs.baseDir, target)
+	if err != nil {
+		return err
+	}
+
+	voters, err = vs.resolveVoters(ctx, voters)
+	if err != nil {
+		return err
+	}
+
+	for _, voter := range voters {
+		if err := vs.ethClient.Discard(ctx, nodeRPCURL(voter), address); err != nil {
+			vs.feedback.Error(ctx, fmt.Sprintf("❌ %s: %v", displayName(voter), err))
+			continue
+		}
+		vs.feedback.Success(ctx, fmt.Sprintf("✅ %s discarded its proposal for %s", displayName(voter), displayName(target)))
+	}
+
+	return nil
+}
+
+// Watch affiche l'ensemble des signers bloc par bloc et signale chaque changement
+func (vs *ValidatorService) Watch(ctx context.Context) error {
+	addresses := loadNodeAddresses(vs.baseDir)
+
+	vs.feedback.Info(ctx, "👀 Watching Clique signers (press Ctrl+C to stop)")
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var lastBlock uint64
+	var previous []common.Address
+	for {
+		if _, snapshot, err := vs.getSnapshot(ctx); err == nil && snapshot.Number != lastBlock {
+			lastBlock = snapshot.Number
+
+			names := make([]string, len(snapshot.Signers))
+			for i, signer := range snapshot.Signers {
+				names[i] = displayName(nodeNameByAddress(addresses, signer))
+			}
+
+			line := fmt.Sprintf("#%d  signers: %s", snapshot.Number, strings.Join(names, ", "))
+			added, removed := diffAddresses(previous, snapshot.Signers)
+			if previous != nil && (len(added) > 0 || len(removed) > 0) {
+				for _, address := range added {
+					line += fmt.Sprintf("  ➕ %s", displayName(nodeNameByAddress(addresses, address)))
+				}
+				for _, address := range removed {
+					line += fmt.Sprintf("  ➖ %s", displayName(nodeNameByAddress(addresses, address)))
+				}
+				vs.feedback.Success(ctx, line)
+			} else {
+				vs.feedback.Info(ctx, line)
+			}
+			previous = snapshot.Signers
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// getSnapshot interroge le premier node joignable
+func (vs *ValidatorService) getSnapshot(ctx context.Context) (string, *ports.CliqueSnapshot, error) {
+	var lastErr error
+	for _, name := range nodeNames {
+		snapshot, err := vs.ethClient.GetSnapshot(ctx, nodeRPCURL(name))
+		if err == nil {
+			return name, snapshot, nil
+		}
+		lastErr = err
+	}
+
+	return "", nil, fmt.Errorf("no node answered clique_getSnapshot: %w", lastErr)
+}
+
+// resolveVoters retourne les nodes votants : ceux demandés, ou les signers actuels connus de benchy
+func (vs *ValidatorService) resolveVoters(ctx context.Context, voters []string) ([]string, error) {
+	if len(voters) > 0 {
+		for i, voter := range voters {
+			voters[i] = strings.ToLower(voter)
+			if _, exists := nodeRPCPorts[voters[i]]; !exists {
+				return nil, fmt.Errorf("unknown node: %s", voter)
+			}
+		}
+		return voters, nil
+	}
+
+	_, snapshot, err := vs.getSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := loadNodeAddresses(vs.baseDir)
+	for _, signer := range snapshot.Signers {
+		for _, name := range nodeNames {
+			if address, exists := addresses[name]; exists && address == signer {
+				voters = append(voters, name)
+			}
+		}
+	}
+
+	if len(voters) == 0 {
+		return nil, fmt.Errorf("none of the current signers is a benchy node")
+	}
+
+	return voters, nil
+}
+
+// diffAddresses retourne les adresses ajoutées et retirées entre deux ensembles de signers
+func diffAddresses(before, after []common.Address) (added, removed []common.Address) {
+	inBefore := make(map[common.Address]bool, len(before))
+	for _, address := range before {
+		inBefore[address] = true
+	}
+	inAfter := make(map[common.Address]bool, len(after))
+	for _, address := range after {
+		inAfter[address] = true
+		if !inBefore[address] {
+			added = append(added, address)
+		}
+	}
+	for _, address := range before {
+		if !inAfter[address] {
+			removed = append(removed, address)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FeePolicy décrit comment calculer les frais EIP-1559 à partir du base fee et du tip suggéré
+type FeePolicy struct {
+	Name string `json:"name"`
+
+	// TipMultiplier s'applique au tip suggéré par le node
+	TipMultiplier float64 `json:"tip_multiplier"`
+	// BaseFeeMultiplier fixe la marge du fee cap : feeCap = BaseFeeMultiplier * baseFee + tip
+	BaseFeeMultiplier float64 `json:"base_fee_multiplier"`
+}
+
+var (
+	// FeePolicyNormal suit les suggestions du node et tolère un doublement du base fee
+	FeePolicyNormal = FeePolicy{Name: "normal", TipMultiplier: 1, BaseFeeMultiplier: 2}
+	// FeePolicyFast double le tip et tolère un triplement du base fee
+	FeePolicyFast = FeePolicy{Name: "fast", TipMultiplier: 2, BaseFeeMultiplier: 3}
+)
+
+// NewFixedFeePolicy multiplie le tip suggéré par un facteur fixe
+func NewFixedFeePolicy(multiplier float64) FeePolicy {
+	return FeePolicy{
+		Name:              fmt.Sprintf("x%g", multiplier),
+		TipMultiplier:     multiplier,
+		BaseFeeMultiplier: 2,
+	}
+}
+
+// ParseFeePolicy interprète "normal", "fast" ou un multiplicateur ("1.5", "x1.5", "1.5x")
+func ParseFeePolicy(value string) (FeePolicy, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	switch value {
+	case "", "normal":
+		return FeePolicyNormal, nil
+	case "fast":
+		return FeePolicyFast, nil
+	}
+
+	multiplier, err := strconv.ParseFloat(strings.Trim(value, "x"), 64)
+	if err != nil || multiplier <= 0 {
+		return FeePolicy{}, fmt.Errorf("invalid fee policy %q (use normal, fast or a multiplier like 1.5)", value)
+	}
+
+	return NewFixedFeePolicy(multiplier), nil
+}
+
+// String retourne le nom de la politique
+func (fp FeePolicy) String() string {
+	return fp.Name
+}
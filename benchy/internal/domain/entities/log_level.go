@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// LogLevel est la sévérité d'une ligne de log, commune à Geth et Nethermind
+type LogLevel int
+
+const (
+	LogLevelUnknown LogLevel = iota // Ligne sans niveau (suite d'une entrée multi-lignes, trace de pile...)
+	LogLevelTrace
+	LogLevelDebug
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+	LogLevelCrit
+)
+
+var logLevelNames = map[LogLevel]string{
+	LogLevelTrace: "TRACE",
+	LogLevelDebug: "DEBUG",
+	LogLevelInfo:  "INFO",
+	LogLevelWarn:  "WARN",
+	LogLevelError: "ERROR",
+	LogLevelCrit:  "CRIT",
+}
+
+// logLevelAliases associe les libellés des deux clients à un niveau : Geth écrit "EROR", "DBUG" et
+// "TRCE" en logfmt, Nethermind "WARNING" et "FATAL"
+var logLevelAliases = map[string]LogLevel{
+	"TRACE": LogLevelTrace, "TRCE": LogLevelTrace,
+	"DEBUG": LogLevelDebug, "DBUG": LogLevelDebug,
+	"INFO": LogLevelInfo,
+	"WARN": LogLevelWarn, "WARNING": LogLevelWarn,
+	"ERROR": LogLevelError, "EROR": LogLevelError,
+	"CRIT": LogLevelCrit, "FATAL": LogLevelCrit,
+}
+
+var (
+	// Geth, format terminal : "WARN [10-16|12:00:00.000] Message   key=value"
+	gethTerminalLevel = regexp.MustCompile(`^(TRACE|DEBUG|INFO|WARN|ERROR|CRIT)\s*\[`)
+	// Geth, format logfmt : "t=2024-10-16T12:00:00+0000 lvl=warn msg=..."
+	gethLogfmtLevel = regexp.MustCompile(`\blvl=([a-zA-Z]+)\b`)
+	// Nethermind, format long : "2024-10-16 12:00:00.0000|WARN|Namespace|Message"
+	nethermindLevel = regexp.MustCompile(`^[^|]*\|\s*(TRACE|DEBUG|INFO|WARN|WARNING|ERROR|FATAL)\s*\|`)
+	// Nethermind, format court sans niveau : "16 Oct 12:00:00 | Message"
+	nethermindShortLine = regexp.MustCompile(`^\d{1,2} [A-Z][a-z]{2} \d{2}:\d{2}:\d{2} \|`)
+)
+
+// ParseLogLevel interprète un niveau saisi par l'utilisateur ("warn", "ERROR", "eror"...)
+func ParseLogLevel(value string) (LogLevel, error) {
+	level, ok := logLevelAliases[strings.ToUpper(strings.TrimSpace(value))]
+	if !ok {
+		return LogLevelUnknown, fmt.Errorf("invalid log level %q (use trace, debug, info, warn, error or crit)", value)
+	}
+	return level, nil
+}
+
+// ClientLogLevel reconnaît le niveau d'une ligne de log Geth ou Nethermind. Les lignes du format court
+// de Nethermind, qui n'affiche pas le niveau, sont INFO ; les autres lignes sont LogLevelUnknown.
+func ClientLogLevel(line string) LogLevel {
+	if match := gethTerminalLevel.FindStringSubmatch(line); match != nil {
+		return logLevelAliases[match[1]]
+	}
+	if match := nethermindLevel.FindStringSubmatch(line); match != nil {
+		return logLevelAliases[match[1]]
+	}
+	if match := gethLogfmtLevel.FindStringSubmatch(line); match != nil {
+		if level, ok := logLevelAliases[strings.ToUpper(match[1])]; ok {
+			return level
+		}
+	}
+	if nethermindShortLine.MatchString(line) {
+		return LogLevelInfo
+	}
+	return LogLevelUnknown
+}
+
+// String retourne le libellé du niveau (ex: "WARN")
+func (l LogLevel) String() string {
+	if name, ok := logLevelNames[l]; ok {
+		return name
+	}
+	return "-"
+}
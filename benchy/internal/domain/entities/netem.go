@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// netemRatePattern accepte les débits au format tc : 1mbit, 512kbit, 1.5gbit, 100kbps...
+var netemRatePattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?[kmgt]?(bit|bps)$`)
+
+// NetemProfile décrit les dégradations appliquées par tc netem au trafic sortant d'un node
+type NetemProfile struct {
+	Delay  time.Duration `json:"delay"`  // Latence ajoutée à chaque paquet
+	Jitter time.Duration `json:"jitter"` // Variation aléatoire de la latence (nécessite Delay)
+	Loss   float64       `json:"loss"`   // Pourcentage de paquets perdus (0-100)
+	Rate   string        `json:"rate"`   // Débit maximal au format tc (ex: "1mbit"), vide : illimité
+}
+
+// Validate vérifie qu'au moins une dégradation est demandée et que les valeurs sont acceptées par tc
+func (p *NetemProfile) Validate() error {
+	if p.Delay < 0 || p.Jitter < 0 {
+		return fmt.Errorf("netem delay and jitter cannot be negative")
+	}
+	if p.Jitter > 0 && p.Delay == 0 {
+		return fmt.Errorf("netem jitter needs a delay")
+	}
+	if p.Loss < 0 || p.Loss > 100 {
+		return fmt.Errorf("invalid netem loss: %g%% (use 0 to 100)", p.Loss)
+	}
+	if p.Rate != "" && !netemRatePattern.MatchString(p.Rate) {
+		return fmt.Errorf("invalid netem rate: %s (e.g. 512kbit, 1mbit, 1gbit)", p.Rate)
+	}
+	if p.Delay == 0 && p.Loss == 0 && p.Rate == "" {
+		return fmt.Errorf("empty netem profile: set a delay, a loss or a rate")
+	}
+	return nil
+}
+
+// Args retourne les paramètres de la discipline netem (ex: delay 100ms 20ms loss 2% rate 1mbit)
+func (p *NetemProfile) Args() []string {
+	var args []string
+	if p.Delay > 0 {
+		args = append(args, "delay", tcTime(p.Delay))
+		if p.Jitter > 0 {
+			args = append(args, tcTime(p.Jitter))
+		}
+	}
+	if p.Loss > 0 {
+		args = append(args, "loss", strconv.FormatFloat(p.Loss, 'f', -1, 64)+"%")
+	}
+	if p.Rate != "" {
+		args = append(args, "rate", p.Rate)
+	}
+	return args
+}
+
+// String retourne le profil sous la forme "delay 100ms ±20ms, loss 2%, rate 1mbit"
+func (p *NetemProfile) String() string {
+	var parts []string
+	if p.Delay > 0 {
+		delay := "delay " + p.Delay.String()
+		if p.Jitter > 0 {
+			delay += " ±" + p.Jitter.String()
+		}
+		parts = append(parts, delay)
+	}
+	if p.Loss > 0 {
+		parts = append(parts, "loss "+strconv.FormatFloat(p.Loss, 'f', -1, 64)+"%")
+	}
+	if p.Rate != "" {
+		parts = append(parts, "rate "+p.Rate)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseNetemLoss interprète un pourcentage de perte ("2%", "0.5")
+func ParseNetemLoss(value string) (float64, error) {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "%")
+	if value == "" {
+		return 0, nil
+	}
+	loss, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid netem loss: %s", value)
+	}
+	return loss, nil
+}
+
+// ParseNetemProfile interprète un profil écrit "delay=100ms,jitter=20ms,loss=2%,rate=1mbit"
+func ParseNetemProfile(spec string) (*NetemProfile, error) {
+	profile := &NetemProfile{}
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid netem setting %q (use key=value)", field)
+		}
+
+		var err error
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "delay":
+			profile.Delay, err = time.ParseDuration(strings.TrimSpace(value))
+		case "jitter":
+			profile.Jitter, err = time.ParseDuration(strings.TrimSpace(value))
+		case "loss":
+			profile.Loss, err = ParseNetemLoss(value)
+		case "rate":
+			profile.Rate = strings.ToLower(strings.TrimSpace(value))
+		default:
+			return nil, fmt.Errorf("unknown netem setting: %s (use delay, jitter, loss or rate)", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid netem %s: %w", key, err)
+		}
+	}
+
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// tcTime écrit une durée au format tc, en millisecondes ou en microsecondes si nécessaire
+func tcTime(d time.Duration) string {
+	if d%time.Millisecond == 0 {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%dus", d.Microseconds())
+}
@@ -3,6 +3,8 @@ package entities
 import (
 	"math/big"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // NetworkStatus représente l'état du réseau
@@ -30,6 +32,9 @@ type Network struct {
 	// Nodes
 	Nodes      []*Node `json:"nodes"`
 	Validators []*Node `json:"validators"`
+
+	// Tokens ERC20 déployés sur le réseau, par symbole
+	Tokens map[string]common.Address `json:"tokens"`
 	
 	// Métriques réseau
 	TotalNodes     int     `json:"total_nodes"`
@@ -55,6 +60,7 @@ func NewNetwork(name string, chainID *big.Int) *Network {
 		NetworkID:   "benchy-network",
 		Nodes:       make([]*Node, 0),
 		Validators:  make([]*Node, 0),
+		Tokens:      make(map[string]common.Address),
 		CreatedAt:   time.Now(),
 	}
 }
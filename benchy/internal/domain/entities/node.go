@@ -2,6 +2,7 @@ package entities
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -27,6 +28,10 @@ const (
 	ClientNethermind ClientType = "nethermind"
 )
 
+// NethermindRPCModules liste les modules JSON-RPC activés sur les nodes Nethermind
+// (modules par défaut + Clique pour la gouvernance des validateurs + Admin pour le peering)
+const NethermindRPCModules = "Eth,Subscribe,Trace,TxPool,Web3,Personal,Proof,Net,Parity,Health,Rpc,Clique,Admin"
+
 // Node représente un node Ethereum dans notre réseau
 type Node struct {
 	Name        string              `json:"name"`
@@ -38,6 +43,7 @@ type Node struct {
 	// Configuration réseau
 	Port        int    `json:"port"`
 	RPCPort     int    `json:"rpc_port"`
+	WSPort      int    `json:"ws_port"`
 	ContainerID string `json:"container_id"`
 	
 	// Status en temps réel
@@ -67,6 +73,7 @@ func NewNode(name string, isValidator bool, client ClientType, port, rpcPort int
 		Client:       client,
 		Port:         port,
 		RPCPort:      rpcPort,
+		WSPort:       rpcPort + 1000, // WebSocket port = RPC port + 1000
 		Status:       StatusOffline,
 		ETHBalance:   big.NewInt(0),
 		TokenBalance: make(map[string]*big.Int),
@@ -79,6 +86,11 @@ func (n *Node) IsOnline() bool {
 	return n.Status == StatusOnline || n.Status == StatusSyncing
 }
 
+// GetWSURL retourne l'URL WebSocket du node
+func (n *Node) GetWSURL() string {
+	return fmt.Sprintf("ws://localhost:%d", n.WSPort)
+}
+
 // GetDisplayName retourne le nom formaté pour l'affichage
 func (n *Node) GetDisplayName() string {
 	if n.IsValidator {
@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Partition sépare les nodes en deux groupes qui ne se voient plus sur le réseau Docker. Les nodes
+// absents des deux groupes restent joignables par les deux côtés.
+type Partition struct {
+	GroupA []string `json:"group_a"`
+	GroupB []string `json:"group_b"`
+}
+
+// String retourne la partition sous la forme "alice,bob | cassandra,driss,elena"
+func (p *Partition) String() string {
+	return strings.Join(p.GroupA, ",") + " | " + strings.Join(p.GroupB, ",")
+}
+
+// Nodes retourne les nodes des deux groupes
+func (p *Partition) Nodes() []string {
+	return append(append([]string{}, p.GroupA...), p.GroupB...)
+}
+
+// Side retourne le groupe d'un node : "A", "B", ou "" s'il n'est dans aucun groupe
+func (p *Partition) Side(node string) string {
+	for _, member := range p.GroupA {
+		if member == node {
+			return "A"
+		}
+	}
+	for _, member := range p.GroupB {
+		if member == node {
+			return "B"
+		}
+	}
+	return ""
+}
+
+// Opposite retourne les nodes du groupe qui ne contient pas node (vide si node n'est dans aucun groupe)
+func (p *Partition) Opposite(node string) []string {
+	switch p.Side(node) {
+	case "A":
+		return p.GroupB
+	case "B":
+		return p.GroupA
+	}
+	return nil
+}
+
+// ParsePartition interprète deux groupes de nodes séparés par des virgules ("alice,bob" et
+// "cassandra,driss,elena"). groupA peut aussi contenir les deux groupes séparés par "|" ; sans
+// groupB, le second groupe est formé des autres nodes.
+func ParsePartition(groupA string, groupB string, nodes []string) (*Partition, error) {
+	if groupB == "" {
+		if first, second, found := strings.Cut(groupA, "|"); found {
+			groupA, groupB = first, second
+		}
+	}
+
+	known := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		known[node] = true
+	}
+
+	seen := make(map[string]bool)
+	parseGroup := func(value string) ([]string, error) {
+		var group []string
+		for _, name := range strings.Split(value, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+			if !known[name] {
+				return nil, fmt.Errorf("unknown node in partition: %s", name)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("node %s appears twice in the partition", name)
+			}
+			seen[name] = true
+			group = append(group, name)
+		}
+		return group, nil
+	}
+
+	partition := &Partition{}
+	var err error
+	if partition.GroupA, err = parseGroup(groupA); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(groupB) == "" {
+		for _, node := range nodes {
+			if !seen[node] {
+				partition.GroupB = append(partition.GroupB, node)
+			}
+		}
+	} else if partition.GroupB, err = parseGroup(groupB); err != nil {
+		return nil, err
+	}
+
+	if len(partition.GroupA) == 0 || len(partition.GroupB) == 0 {
+		return nil, fmt.Errorf("invalid partition %q: both groups need at least one node", partition)
+	}
+	return partition, nil
+}
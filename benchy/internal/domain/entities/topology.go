@@ -0,0 +1,146 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TopologyKind identifie la forme du graphe de peering entre les nodes
+type TopologyKind string
+
+const (
+	TopologyMesh  TopologyKind = "mesh"  // Chaque node connecté à tous les autres
+	TopologyRing  TopologyKind = "ring"  // Chaque node connecté à son suivant, le dernier au premier
+	TopologyStar  TopologyKind = "star"  // Tous les nodes connectés à un hub
+	TopologyEdges TopologyKind = "edges" // Liste explicite de liens
+	TopologyNone  TopologyKind = "none"  // Aucun peering automatique
+)
+
+// PeerLink représente une connexion P2P : From appelle admin_addPeer avec l'enode de To.
+// Une connexion devp2p étant bidirectionnelle, un seul sens suffit.
+type PeerLink struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// String retourne le lien sous la forme "alice-bob"
+func (l PeerLink) String() string {
+	return l.From + "-" + l.To
+}
+
+// Topology décrit les connexions statiques à établir entre les nodes
+type Topology struct {
+	Kind  TopologyKind `json:"kind"`
+	Hub   string       `json:"hub,omitempty"` // Centre de l'étoile
+	Links []PeerLink   `json:"links"`
+}
+
+// ParseTopology interprète "mesh", "ring", "star", "star:<hub>", "none" ou une liste de liens
+// ("alice-bob,bob-cassandra") pour les nodes donnés, dans leur ordre
+func ParseTopology(value string, nodes []string) (*Topology, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	known := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		known[node] = true
+	}
+
+	switch {
+	case value == "none":
+		return &Topology{Kind: TopologyNone}, nil
+
+	case value == "" || value == "mesh" || value == "full":
+		topology := &Topology{Kind: TopologyMesh}
+		for i := range nodes {
+			for j := i + 1; j < len(nodes); j++ {
+				topology.Links = append(topology.Links, PeerLink{From: nodes[i], To: nodes[j]})
+			}
+		}
+		return topology, nil
+
+	case value == "ring":
+		topology := &Topology{Kind: TopologyRing}
+		switch len(nodes) {
+		case 0, 1:
+		case 2:
+			// Le lien retour doublerait le lien aller
+			topology.Links = []PeerLink{{From: nodes[0], To: nodes[1]}}
+		default:
+			for i := range nodes {
+				topology.Links = append(topology.Links, PeerLink{From: nodes[i], To: nodes[(i+1)%len(nodes)]})
+			}
+		}
+		return topology, nil
+
+	case value == "star" || strings.HasPrefix(value, "star:"):
+		if len(nodes) == 0 {
+			return &Topology{Kind: TopologyStar}, nil
+		}
+		hub := nodes[0]
+		if strings.HasPrefix(value, "star:") {
+			hub = strings.TrimPrefix(value, "star:")
+			if !known[hub] {
+				return nil, fmt.Errorf("unknown star hub: %s", hub)
+			}
+		}
+
+		topology := &Topology{Kind: TopologyStar, Hub: hub}
+		for _, node := range nodes {
+			if node != hub {
+				topology.Links = append(topology.Links, PeerLink{From: node, To: hub})
+			}
+		}
+		return topology, nil
+	}
+
+	// Liste explicite de liens
+	topology := &Topology{Kind: TopologyEdges}
+	seen := make(map[PeerLink]bool)
+	for _, edge := range strings.Split(value, ",") {
+		ends := strings.Split(strings.TrimSpace(edge), "-")
+		if len(ends) != 2 || ends[0] == "" || ends[1] == "" {
+			return nil, fmt.Errorf("invalid topology %q (use mesh, ring, star, star:<node>, none or edges like alice-bob,bob-cassandra)", value)
+		}
+
+		link := PeerLink{From: ends[0], To: ends[1]}
+		for _, node := range []string{link.From, link.To} {
+			if !known[node] {
+				return nil, fmt.Errorf("unknown node in topology: %s", node)
+			}
+		}
+		if link.From == link.To {
+			return nil, fmt.Errorf("invalid topology edge %s: a node cannot peer with itself", link)
+		}
+
+		reverse := PeerLink{From: link.To, To: link.From}
+		if seen[link] || seen[reverse] {
+			continue
+		}
+		seen[link] = true
+		topology.Links = append(topology.Links, link)
+	}
+
+	return topology, nil
+}
+
+// Neighbours retourne les nodes reliés à node, dans l'ordre des liens
+func (t *Topology) Neighbours(node string) []string {
+	var neighbours []string
+	for _, link := range t.Links {
+		switch node {
+		case link.From:
+			neighbours = append(neighbours, link.To)
+		case link.To:
+			neighbours = append(neighbours, link.From)
+		}
+	}
+	return neighbours
+}
+
+// String retourne le nom de la topologie
+func (t *Topology) String() string {
+	if t.Kind == TopologyStar && t.Hub != "" {
+		return fmt.Sprintf("%s:%s", t.Kind, t.Hub)
+	}
+	return string(t.Kind)
+}
@@ -28,6 +28,16 @@ const (
 	TxTypeReplacement TransactionType = "replacement"
 )
 
+// ReplacementMode représente la façon de remplacer une transaction en attente
+type ReplacementMode string
+
+const (
+	// ReplaceSpeedUp renvoie le même contenu avec des frais plus élevés
+	ReplaceSpeedUp ReplacementMode = "speedup"
+	// ReplaceCancel envoie 0 ETH à soi-même avec le même nonce
+	ReplaceCancel ReplacementMode = "cancel"
+)
+
 // Transaction représente une transaction Ethereum
 type Transaction struct {
 	Hash     common.Hash       `json:"hash"`
@@ -40,6 +50,13 @@ type Transaction struct {
 	Value    *big.Int       `json:"value"`
 	Gas      uint64         `json:"gas"`
 	GasPrice *big.Int       `json:"gas_price"`
+	// Champs EIP-1559 (si GasFeeCap est défini, la transaction est de type dynamic-fee)
+	GasTipCap *big.Int `json:"gas_tip_cap,omitempty"`
+	GasFeeCap *big.Int `json:"gas_fee_cap,omitempty"`
+	// Transaction remplacée par celle-ci (même nonce), pour les speed-up/cancel
+	Replaces common.Hash `json:"replaces,omitempty"`
+	// Politique de frais utilisée pour compléter GasTipCap/GasFeeCap (défaut du client si nil)
+	FeePolicy *FeePolicy `json:"fee_policy,omitempty"`
 	Nonce    uint64         `json:"nonce"`
 	Data     []byte         `json:"data"`
 	
@@ -91,6 +108,11 @@ func (t *Transaction) IsConfirmed() bool {
 	return t.Status == TxStatusConfirmed
 }
 
+// IsDynamicFee retourne true si la transaction utilise les frais EIP-1559
+func (t *Transaction) IsDynamicFee() bool {
+	return t.GasPrice == nil || t.GasFeeCap != nil
+}
+
 // GetValueETH retourne la valeur en ETH (float64)
 func (t *Transaction) GetValueETH() float64 {
 	if t.Value == nil {
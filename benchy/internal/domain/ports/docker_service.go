@@ -5,14 +5,35 @@ import (
 	"benchy/internal/domain/entities"
 )
 
+// Labels posés sur toutes les ressources Docker créées par benchy (containers, réseau, volumes) :
+// la découverte, la surveillance et la suppression passent par eux, jamais par les noms
+const (
+	LabelNetwork       = "benchy.network"        // Réseau benchy propriétaire (ex: benchy-network)
+	LabelRole          = "benchy.role"           // Rôle de la ressource (RoleNode, RoleInit...)
+	LabelNodeName      = "benchy.node.name"      // Node du container ou du volume (ex: alice)
+	LabelNodeValidator = "benchy.node.validator" // "true" pour les validateurs
+	LabelNodeClient    = "benchy.node.client"    // Client du node (geth, nethermind)
+)
+
+// Rôles des ressources Docker benchy (valeurs de LabelRole)
+const (
+	RoleNode    = "node"    // Container d'un node
+	RoleInit    = "init"    // Container éphémère d'initialisation du genesis
+	RoleNetwork = "network" // Réseau Docker des nodes
+	RoleData    = "data"    // Volume de données d'un node
+	RoleHelper  = "helper"  // Container éphémère d'outillage réseau (iptables, tc) dans l'espace réseau d'un node
+)
+
 // ContainerInfo représente les informations d'un container
 type ContainerInfo struct {
 	ID       string
 	Name     string
-	Status   string
+	Status   string // Ex: "Up 5 minutes", "Exited (0) 2 minutes ago"
+	State    string // running, exited, created...
 	Image    string
 	Ports    []string
 	Networks []string
+	Labels   map[string]string
 	
 	// Métriques
 	CPUUsage    float64
@@ -35,26 +56,35 @@ type DockerService interface {
 	GetContainerInfo(ctx context.Context, containerID string) (*ContainerInfo, error)
 	GetContainerLogs(ctx context.Context, containerID string, tail int) ([]string, error)
 	IsContainerRunning(ctx context.Context, containerID string) (bool, error)
+	ListContainers(ctx context.Context, labels map[string]string) ([]*ContainerInfo, error)
 	
 	// Métriques
 	GetContainerStats(ctx context.Context, containerID string) (*ContainerStats, error)
 	
 	// Gestion du réseau Docker
-	CreateNetwork(ctx context.Context, networkName string) error
+	CreateNetwork(ctx context.Context, networkName string, labels map[string]string) error
 	RemoveNetwork(ctx context.Context, networkName string) error
+	ListNetworks(ctx context.Context, labels map[string]string) ([]string, error)
 	ConnectToNetwork(ctx context.Context, containerID, networkName string) error
+	GetContainerIP(ctx context.Context, containerID, networkName string) (string, error)
+	
+	// Volumes Docker
+	CreateVolume(ctx context.Context, volumeName string, labels map[string]string) error
+	RemoveVolume(ctx context.Context, volumeName string) error
+	ListVolumes(ctx context.Context, labels map[string]string) ([]string, error)
 }
 
 // ContainerConfig représente la configuration d'un container
 type ContainerConfig struct {
 	Image       string
 	Name        string
-	Ports       map[string]string // host:container
-	Volumes     map[string]string // host:container
+	Ports       map[string]string // host:container ; l'hôte peut fixer l'adresse d'écoute ("127.0.0.1:9545")
+	Volumes     map[string]string // host:container (chemin de l'hôte ou nom de volume Docker)
 	Environment []string
 	Command     []string
-	NetworkMode string
+	NetworkMode string   // Nom du réseau Docker, ou "container:<id>" pour partager celui d'un container
 	Labels      map[string]string
+	CapAdd      []string // Capacités Linux ajoutées (ex: NET_ADMIN)
 }
 
 // ContainerStats représente les statistiques d'un container
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"math/big"
 	"benchy/internal/domain/entities"
 	"github.com/ethereum/go-ethereum/common"
@@ -17,18 +18,43 @@ type EthereumService interface {
 	// Informations blockchain
 	GetLatestBlockNumber(ctx context.Context, nodeURL string) (uint64, error)
 	GetBlockByNumber(ctx context.Context, nodeURL string, blockNumber uint64) (*BlockInfo, error)
+	// GetHeaderByNumber récupère seulement l'en-tête d'un bloc ; nil : le dernier bloc
+	GetHeaderByNumber(ctx context.Context, nodeURL string, blockNumber *uint64) (*BlockHeader, error)
+	GetTransaction(ctx context.Context, nodeURL string, txHash common.Hash) (*TransactionInfo, error)
 	GetPeerCount(ctx context.Context, nodeURL string) (int, error)
 	GetPendingTransactionCount(ctx context.Context, nodeURL string) (int, error)
+	// GetNodeSnapshot récupère en une seule requête batch toutes les métriques affichées par infos
+	GetNodeSnapshot(ctx context.Context, nodeURL string, address common.Address, tokens map[string]common.Address) (*NodeSnapshot, error)
+	
+	// Mempool (module JSON-RPC txpool, avec repli sur le module eth)
+	GetTxPoolStatus(ctx context.Context, nodeURL string) (*TxPoolStatus, error)
+	GetTxPoolContent(ctx context.Context, nodeURL string) (*TxPoolContent, error)
+	GetTxPoolInspect(ctx context.Context, nodeURL string) (*TxPoolInspect, error)
 	
 	// Gestion des comptes
 	GetBalance(ctx context.Context, nodeURL string, address common.Address) (*big.Int, error)
 	GetNonce(ctx context.Context, nodeURL string, address common.Address) (uint64, error)
+	// GetMinedNonce retourne le nonce au dernier bloc : celui des transactions déjà minées, sans le mempool
+	GetMinedNonce(ctx context.Context, nodeURL string, address common.Address) (uint64, error)
+	GetCode(ctx context.Context, nodeURL string, address common.Address) ([]byte, error)
+	
+	// Signature locale : les transactions sont signées avec les clés enregistrées, selon la politique de frais du client
+	AddAccount(privateKey *ecdsa.PrivateKey) common.Address
+	SetFeePolicy(policy entities.FeePolicy)
 	
 	// Transactions
 	SendTransaction(ctx context.Context, nodeURL string, tx *entities.Transaction) (common.Hash, error)
 	GetTransactionStatus(ctx context.Context, nodeURL string, txHash common.Hash) (entities.TransactionStatus, error)
 	GetTransactionReceipt(ctx context.Context, nodeURL string, txHash common.Hash) (*TransactionReceipt, error)
 	
+	// Remplacement d'une transaction en attente (même nonce, frais augmentés)
+	ReplaceTransaction(ctx context.Context, nodeURL string, original *entities.Transaction, mode entities.ReplacementMode) (*entities.Transaction, error)
+	
+	// Gas et frais EIP-1559
+	EstimateGas(ctx context.Context, nodeURL string, tx *entities.Transaction) (uint64, error)
+	SuggestGasTipCap(ctx context.Context, nodeURL string) (*big.Int, error)
+	SuggestFees(ctx context.Context, nodeURL string, policy entities.FeePolicy) (*FeeSuggestion, error)
+	
 	// Smart contracts
 	DeployContract(ctx context.Context, nodeURL string, contractCode []byte, from common.Address) (common.Address, common.Hash, error)
 	CallContract(ctx context.Context, nodeURL string, contractAddress common.Address, data []byte) ([]byte, error)
@@ -36,6 +62,124 @@ type EthereumService interface {
 	// ERC20 tokens
 	GetTokenBalance(ctx context.Context, nodeURL string, tokenAddress, holderAddress common.Address) (*big.Int, error)
 	TransferToken(ctx context.Context, nodeURL string, tokenAddress, from, to common.Address, amount *big.Int) (common.Hash, error)
+	
+	// Gouvernance Clique (module JSON-RPC clique)
+	GetSigners(ctx context.Context, nodeURL string) ([]common.Address, error)
+	GetSnapshot(ctx context.Context, nodeURL string) (*CliqueSnapshot, error)
+	Propose(ctx context.Context, nodeURL string, address common.Address, authorize bool) error
+	Discard(ctx context.Context, nodeURL string, address common.Address) error
+
+	// Peering P2P (module JSON-RPC admin)
+	GetNodeInfo(ctx context.Context, nodeURL string) (*NodeP2PInfo, error)
+	AddPeer(ctx context.Context, nodeURL string, enode string) error
+
+	// Abonnements WebSocket (eth_subscribe), avec reconnexion automatique
+	SubscribeNewHeads(ctx context.Context, wsURL string, heads chan<- *BlockHeader) (Subscription, error)
+	SubscribePendingTransactions(ctx context.Context, wsURL string, hashes chan<- common.Hash) (Subscription, error)
+}
+
+// Subscription représente un abonnement actif à un node
+type Subscription interface {
+	// Unsubscribe arrête l'abonnement et ferme le canal Err
+	Unsubscribe()
+	// Err reçoit l'erreur qui a définitivement terminé l'abonnement
+	Err() <-chan error
+}
+
+// BlockHeader représente l'en-tête d'un nouveau bloc reçu par abonnement
+type BlockHeader struct {
+	Number     uint64
+	Hash       common.Hash
+	ParentHash common.Hash
+	Timestamp  uint64
+	GasLimit   uint64
+	GasUsed    uint64
+	BaseFee    *big.Int
+	Miner      common.Address
+}
+
+// TxPoolStatus représente le nombre de transactions du mempool (txpool_status).
+// Queued vaut -1 quand le node ne le communique pas.
+type TxPoolStatus struct {
+	Pending int // Exécutables : nonces contigus à partir du nonce du compte
+	Queued  int // Bloquées par un trou de nonce
+}
+
+// TxPoolContent regroupe les transactions du mempool par expéditeur, triées par nonce (txpool_content)
+type TxPoolContent struct {
+	Pending map[common.Address][]PoolTransaction
+	Queued  map[common.Address][]PoolTransaction
+}
+
+// PoolTransaction représente une transaction en attente dans le mempool
+type PoolTransaction struct {
+	Hash      common.Hash
+	From      common.Address
+	To        *common.Address // nil pour un déploiement
+	Nonce     uint64
+	Value     *big.Int
+	Gas       uint64
+	Type      uint8
+	GasPrice  *big.Int // Transactions legacy
+	GasTipCap *big.Int // Transactions EIP-1559
+	GasFeeCap *big.Int
+}
+
+// TxPoolInspect résume chaque transaction du mempool en une ligne, par expéditeur et nonce (txpool_inspect)
+type TxPoolInspect struct {
+	Pending map[common.Address]map[uint64]string
+	Queued  map[common.Address]map[uint64]string
+}
+
+// NodeP2PInfo représente l'identité P2P d'un node (admin_nodeInfo)
+type NodeP2PInfo struct {
+	ID         string
+	Name       string // Version du client (ex: "Geth/v1.13.15-stable/linux-amd64/go1.21.6")
+	Enode      string // enode://<clé publique>@<ip>:<port>, tel qu'annoncé par le node
+	IP         string
+	ListenAddr string
+}
+
+// CliqueSnapshot représente l'état du consensus Clique à un bloc donné
+type CliqueSnapshot struct {
+	Number  uint64
+	Hash    common.Hash
+	Signers []common.Address          // Validateurs autorisés, triés
+	Recents map[uint64]common.Address // Derniers signataires (bloc → signer)
+	Votes   []CliqueVote              // Votes en cours
+	Tally   map[common.Address]CliqueTally
+}
+
+// CliqueVote représente le vote d'un signer pour (dés)autoriser une adresse
+type CliqueVote struct {
+	Signer    common.Address
+	Block     uint64
+	Address   common.Address
+	Authorize bool
+}
+
+// CliqueTally représente le décompte des votes pour une adresse
+type CliqueTally struct {
+	Authorize bool
+	Votes     int
+}
+
+// FeeSuggestion représente les frais EIP-1559 calculés pour une politique donnée
+type FeeSuggestion struct {
+	BaseFee   *big.Int // Base fee du dernier bloc
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+	Policy    entities.FeePolicy
+}
+
+// NodeSnapshot regroupe les métriques d'un node collectées en un seul aller-retour JSON-RPC.
+// Les champs optionnels restent nil quand l'appel correspondant a échoué.
+type NodeSnapshot struct {
+	LatestBlock   uint64
+	PeerCount     *int
+	PendingTxs    *int
+	Balance       *big.Int            // nil si aucune adresse demandée
+	TokenBalances map[string]*big.Int // par symbole
 }
 
 // BlockInfo représente les informations d'un bloc
@@ -49,6 +193,28 @@ type BlockInfo struct {
 	GasUsed      uint64
 	Transactions []common.Hash
 	Miner        common.Address
+	BaseFee      *big.Int          // nil avant London
+	ExtraData    []byte
+	Signer       common.Address    // Validateur Clique ayant scellé le bloc, retrouvé depuis extraData
+	TxDetails    []TransactionInfo // Transactions complètes, dans l'ordre du bloc
+}
+
+// TransactionInfo représente une transaction telle que renvoyée par le node (eth_getTransactionByHash)
+type TransactionInfo struct {
+	Hash             common.Hash
+	From             common.Address
+	To               *common.Address // nil pour un déploiement
+	Nonce            uint64
+	Value            *big.Int
+	Gas              uint64
+	Type             uint8
+	GasPrice         *big.Int // Transactions legacy
+	GasTipCap        *big.Int // Transactions EIP-1559
+	GasFeeCap        *big.Int
+	Input            []byte
+	BlockNumber      *uint64 // nil tant que la transaction est en attente
+	BlockHash        common.Hash
+	TransactionIndex uint
 }
 
 // TransactionReceipt représente le reçu d'une transaction
@@ -63,6 +229,18 @@ type TransactionReceipt struct {
 	Status          uint64
 	ContractAddress common.Address
 	Logs            []LogEntry
+	Events          []DecodedEvent // Logs reconnus par une ABI enregistrée
+}
+
+// EventsNamed retourne les événements décodés portant ce nom (ex: "Transfer")
+func (r *TransactionReceipt) EventsNamed(name string) []DecodedEvent {
+	var events []DecodedEvent
+	for _, event := range r.Events {
+		if event.Name == name {
+			events = append(events, event)
+		}
+	}
+	return events
 }
 
 // LogEntry représente un log d'événement
@@ -71,3 +249,12 @@ type LogEntry struct {
 	Topics  []common.Hash
 	Data    []byte
 }
+
+// DecodedEvent représente un log décodé avec son ABI
+type DecodedEvent struct {
+	Address   common.Address         // Contrat émetteur
+	Name      string                 // Nom de l'événement (ex: "Transfer")
+	Signature string                 // Signature canonique (ex: "Transfer(address,address,uint256)")
+	Args      map[string]interface{} // Arguments typés (common.Address, *big.Int, bool...)
+	LogIndex  int                    // Position du log dans le reçu
+}
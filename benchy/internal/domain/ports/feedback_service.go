@@ -26,6 +26,7 @@ type FeedbackService interface {
 	// Interactive
 	Confirm(ctx context.Context, message string) (bool, error)
 	Input(ctx context.Context, prompt string) (string, error)
+	SecretInput(ctx context.Context, prompt string) (string, error) // Saisie sans écho (passphrases)
 }
 
 // ProgressTracker représente un tracker de progression
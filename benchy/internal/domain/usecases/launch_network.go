@@ -52,7 +52,10 @@ func (uc *LaunchNetworkUseCase) Execute(ctx context.Context) error {
 	uc.feedback.Info(ctx, "   - Consensus: Clique")
 	
 	// 4. Créer le réseau Docker
-	if err := uc.dockerService.CreateNetwork(ctx, "benchy-network"); err != nil {
+	if err := uc.dockerService.CreateNetwork(ctx, "benchy-network", map[string]string{
+		ports.LabelNetwork: "benchy-network",
+		ports.LabelRole:    ports.RoleNetwork,
+	}); err != nil {
 		return fmt.Errorf("failed to create docker network: %w", err)
 	}
 	
@@ -129,12 +132,15 @@ func (uc *LaunchNetworkUseCase) launchNode(ctx context.Context, node *entities.N
 		Ports:       map[string]string{
 			fmt.Sprintf("%d", node.Port):    fmt.Sprintf("%d", node.Port),
 			fmt.Sprintf("%d", node.RPCPort): fmt.Sprintf("%d", node.RPCPort),
+			fmt.Sprintf("127.0.0.1:%d", node.WSPort): fmt.Sprintf("%d", node.WSPort), // admin : localhost seulement
 		},
 		NetworkMode: "benchy-network",
 		Labels: map[string]string{
-			"benchy.node.name":        node.Name,
-			"benchy.node.validator":   fmt.Sprintf("%t", node.IsValidator),
-			"benchy.node.client":      string(node.Client),
+			ports.LabelNetwork:       "benchy-network",
+			ports.LabelRole:          ports.RoleNode,
+			ports.LabelNodeName:      node.Name,
+			ports.LabelNodeValidator: fmt.Sprintf("%t", node.IsValidator),
+			ports.LabelNodeClient:    string(node.Client),
 		},
 	}
 	
@@ -181,11 +187,11 @@ func (uc *LaunchNetworkUseCase) getGethCommand(node *entities.Node) []string {
 		"--http",
 		"--http.addr", "0.0.0.0",
 		"--http.port", fmt.Sprintf("%d", node.RPCPort),
-		"--http.api", "eth,net,web3,personal,miner",
+		"--http.api", "eth,net,web3,personal,miner,clique,txpool",
 		"--ws",
 		"--ws.addr", "0.0.0.0",
-		"--ws.port", fmt.Sprintf("%d", node.RPCPort+1000),
-		"--ws.api", "eth,net,web3,personal,miner",
+		"--ws.port", fmt.Sprintf("%d", node.WSPort),
+		"--ws.api", "eth,net,web3,personal,miner,admin",
 		"--allow-insecure-unlock",
 		"--nodiscover",
 		"--syncmode", "full",
@@ -209,6 +215,9 @@ func (uc *LaunchNetworkUseCase) getNethermindCommand(node *entities.Node) []stri
 		"--JsonRpc.Enabled", "true",
 		"--JsonRpc.Host", "0.0.0.0",
 		"--JsonRpc.Port", fmt.Sprintf("%d", node.RPCPort),
+		"--JsonRpc.WebSocketsPort", fmt.Sprintf("%d", node.WSPort),
+		"--Init.WebSocketsEnabled", "true",
+		"--JsonRpc.EnabledModules", entities.NethermindRPCModules,
 	}
 }
 
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 	"math/big"
 
@@ -17,6 +18,7 @@ type MonitorNetworkUseCase struct {
 	ethService      ports.EthereumService
 	monitoringService ports.MonitoringService
 	feedback        ports.FeedbackService
+	queryTimeout    time.Duration // Borne la collecte des métriques d'un node, fixée par la couche service
 }
 
 // NewMonitorNetworkUseCase crée une nouvelle instance
@@ -26,6 +28,7 @@ func NewMonitorNetworkUseCase(
 	ethService ports.EthereumService,
 	monitoringService ports.MonitoringService,
 	feedback ports.FeedbackService,
+	queryTimeout time.Duration,
 ) *MonitorNetworkUseCase {
 	return &MonitorNetworkUseCase{
 		networkRepo:     networkRepo,
@@ -33,6 +36,7 @@ func NewMonitorNetworkUseCase(
 		ethService:      ethService,
 		monitoringService: monitoringService,
 		feedback:        feedback,
+		queryTimeout:    queryTimeout,
 	}
 }
 
@@ -82,8 +86,23 @@ func (uc *MonitorNetworkUseCase) displayNetworkInfo(ctx context.Context, network
 	var tableData [][]string
 	headers := []string{"Node", "Status", "Latest Block", "Peers", "CPU/Memory", "ETH Balance", "Mempool"}
 	
-	for _, node := range network.Nodes {
-		nodeInfo, err := uc.getNodeInfo(ctx, node)
+	// Interroger tous les nodes en parallèle, chacun avec son propre timeout pour qu'un node lent ne bloque pas le tableau
+	infos := make([]*NodeInfo, len(network.Nodes))
+	errs := make([]error, len(network.Nodes))
+	var wg sync.WaitGroup
+	for i, node := range network.Nodes {
+		wg.Add(1)
+		go func(i int, node *entities.Node) {
+			defer wg.Done()
+			nodeCtx, cancel := context.WithTimeout(ctx, uc.queryTimeout)
+			defer cancel()
+			infos[i], errs[i] = uc.getNodeInfo(nodeCtx, network, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	for i, node := range network.Nodes {
+		nodeInfo, err := infos[i], errs[i]
 		if err != nil {
 			// Node offline ou erreur
 			tableData = append(tableData, []string{
@@ -139,7 +158,7 @@ type NodeInfo struct {
 }
 
 // getNodeInfo récupère les informations d'un node
-func (uc *MonitorNetworkUseCase) getNodeInfo(ctx context.Context, node *entities.Node) (*NodeInfo, error) {
+func (uc *MonitorNetworkUseCase) getNodeInfo(ctx context.Context, network *entities.Network, node *entities.Node) (*NodeInfo, error) {
 	info := &NodeInfo{
 		Name: node.Name,
 	}
@@ -160,38 +179,37 @@ func (uc *MonitorNetworkUseCase) getNodeInfo(ctx context.Context, node *entities
 		}
 	}
 	
-	// Se connecter au node Ethereum
+	// Récupérer toutes les métriques blockchain en un seul aller-retour
 	nodeURL := fmt.Sprintf("http://localhost:%d", node.RPCPort)
-	if err := uc.ethService.ConnectToNode(ctx, nodeURL); err != nil {
+	snapshot, err := uc.ethService.GetNodeSnapshot(ctx, nodeURL, node.Address, network.Tokens)
+	if err != nil {
 		info.StatusDisplay = "❌ Offline"
-		return info, fmt.Errorf("failed to connect to ethereum node: %w", err)
-	}
-	
-	// Récupérer les métriques blockchain
-	latestBlock, err := uc.ethService.GetLatestBlockNumber(ctx, nodeURL)
-	if err == nil {
-		info.LatestBlock = latestBlock
+		return info, fmt.Errorf("failed to query ethereum node: %w", err)
 	}
 	
-	peerCount, err := uc.ethService.GetPeerCount(ctx, nodeURL)
-	if err == nil {
-		info.PeerCount = peerCount
+	info.LatestBlock = snapshot.LatestBlock
+	if snapshot.PeerCount != nil {
+		info.PeerCount = *snapshot.PeerCount
 	}
-	
-	pendingTxs, err := uc.ethService.GetPendingTransactionCount(ctx, nodeURL)
-	if err == nil {
-		info.PendingTxs = pendingTxs
+	if snapshot.PendingTxs != nil {
+		info.PendingTxs = *snapshot.PendingTxs
 	}
 	
-	// Récupérer la balance ETH
-	balance, err := uc.ethService.GetBalance(ctx, nodeURL, node.Address)
-	if err == nil {
+	if snapshot.Balance != nil {
 		// Convertir wei en ETH
-		ethBalance := new(big.Float).SetInt(balance)
+		ethBalance := new(big.Float).SetInt(snapshot.Balance)
 		ethBalance.Quo(ethBalance, big.NewFloat(1e18))
 		info.ETHBalance, _ = ethBalance.Float64()
 	}
 	
+	// Balances des tokens déployés
+	for symbol, tokenBalance := range snapshot.TokenBalances {
+		if node.TokenBalance == nil {
+			node.TokenBalance = make(map[string]*big.Int)
+		}
+		node.TokenBalance[symbol] = tokenBalance
+	}
+	
 	// Déterminer le status d'affichage
 	if info.PeerCount > 0 {
 		info.StatusDisplay = "✅ Online"
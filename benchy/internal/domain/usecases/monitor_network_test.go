@@ -0,0 +1,205 @@
+package usecases
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/ethereum"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Les ports hors Ethereum sont simulés : seules les méthodes utilisées par le use case sont implémentées,
+// les autres paniquent via l'interface embarquée nil
+
+type fakeNetworkRepository struct {
+	ports.NetworkRepository
+	network *entities.Network
+}
+
+func (r *fakeNetworkRepository) GetNetwork(ctx context.Context, name string) (*entities.Network, error) {
+	return r.network, nil
+}
+
+// fakeDockerService considère comme arrêté tout container absent de running
+type fakeDockerService struct {
+	ports.DockerService
+	running map[string]bool
+}
+
+func (d *fakeDockerService) IsContainerRunning(ctx context.Context, containerID string) (bool, error) {
+	return d.running[containerID], nil
+}
+
+func (d *fakeDockerService) GetContainerStats(ctx context.Context, containerID string) (*ports.ContainerStats, error) {
+	return &ports.ContainerStats{CPUUsage: 12.5, MemoryUsage: 256 * 1024 * 1024}, nil
+}
+
+type fakeMonitoringService struct {
+	ports.MonitoringService
+}
+
+func (m *fakeMonitoringService) GetNetworkMetrics(ctx context.Context, networkName string) (*ports.NetworkMetrics, error) {
+	return &ports.NetworkMetrics{NetworkName: networkName}, nil
+}
+
+// fakeFeedback garde le dernier tableau affiché et les messages
+type fakeFeedback struct {
+	ports.FeedbackService
+	rows     [][]string
+	messages []string
+}
+
+func (f *fakeFeedback) Info(ctx context.Context, message string) error {
+	f.messages = append(f.messages, message)
+	return nil
+}
+
+func (f *fakeFeedback) Error(ctx context.Context, message string) error {
+	f.messages = append(f.messages, message)
+	return nil
+}
+
+func (f *fakeFeedback) DisplayTable(ctx context.Context, headers []string, rows [][]string) error {
+	f.rows = rows
+	return nil
+}
+
+// stalledNode bloque les requêtes d'un node jusqu'à l'expiration de leur contexte
+type stalledNode struct {
+	*ethereum.SimulatedClient
+	nodeURL string
+}
+
+func (s *stalledNode) GetNodeSnapshot(ctx context.Context, nodeURL string, address common.Address, tokens map[string]common.Address) (*ports.NodeSnapshot, error) {
+	if nodeURL == s.nodeURL {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return s.SimulatedClient.GetNodeSnapshot(ctx, nodeURL, address, tokens)
+}
+
+// newMonitoredNetwork crée une chaîne simulée dont alice et bob sont validateurs, et le réseau benchy
+// correspondant : alice et bob tournent, le container de cassandra est arrêté
+func newMonitoredNetwork(t *testing.T) (*ethereum.SimulatedClient, *ecdsa.PrivateKey, *entities.Network) {
+	t.Helper()
+
+	keys := make(map[string]*ecdsa.PrivateKey)
+	generator := config.NewGenesisGenerator()
+	network := &entities.Network{Name: "benchy-network", Status: entities.NetworkStatusRunning}
+	for i, name := range []string{"alice", "bob", "cassandra"} {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("GenerateKey: %v", err)
+		}
+		keys[name] = key
+		address := crypto.PubkeyToAddress(key.PublicKey)
+		generator.AddValidator(address)
+
+		node := &entities.Node{Name: name, Address: address, RPCPort: 8545 + i, ContainerID: "container-" + name, IsValidator: true}
+		network.Nodes = append(network.Nodes, node)
+		network.Validators = append(network.Validators, node)
+	}
+
+	genesis, err := generator.GenerateGenesis()
+	if err != nil {
+		t.Fatalf("GenerateGenesis: %v", err)
+	}
+	client, err := ethereum.NewSimulatedClient(genesis, time.Hour)
+	if err != nil {
+		t.Fatalf("NewSimulatedClient: %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return client, keys["alice"], network
+}
+
+func newMonitorUseCase(network *entities.Network, ethService ports.EthereumService, fb *fakeFeedback, timeout time.Duration) *MonitorNetworkUseCase {
+	docker := &fakeDockerService{running: map[string]bool{"container-alice": true, "container-bob": true}}
+	return NewMonitorNetworkUseCase(&fakeNetworkRepository{network: network}, docker, ethService, &fakeMonitoringService{}, fb, timeout)
+}
+
+func TestMonitorNetworkSimulated(t *testing.T) {
+	ctx := context.Background()
+	client, aliceKey, network := newMonitoredNetwork(t)
+	alice := client.AddAccount(aliceKey)
+	bob := network.Nodes[1].Address
+
+	// Deux blocs, un token BY déployé, puis un transfert laissé dans le mempool
+	code, err := contracts.BYTokenDeployCode(contracts.ToTokenUnits(1000))
+	if err != nil {
+		t.Fatalf("BYTokenDeployCode: %v", err)
+	}
+	token, _, err := client.DeployContract(ctx, "", code, alice)
+	if err != nil {
+		t.Fatalf("DeployContract: %v", err)
+	}
+	client.Mine()
+	client.Mine()
+	network.Tokens = map[string]common.Address{contracts.BYTokenSymbol: token}
+
+	transfer := entities.NewTransaction(alice, bob, big.NewInt(params.Ether), entities.TxTypeTransfer)
+	if _, err := client.SendTransaction(ctx, "", transfer); err != nil {
+		t.Fatalf("SendTransaction: %v", err)
+	}
+
+	fb := &fakeFeedback{}
+	if err := newMonitorUseCase(network, client, fb, time.Second).Execute(ctx, 0); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	if len(fb.rows) != len(network.Nodes) {
+		t.Fatalf("%d rows, want %d", len(fb.rows), len(network.Nodes))
+	}
+	// La chaîne simulée n'a pas de pairs : un node qui répond est affiché en synchronisation
+	want := map[string][]string{
+		"alice":     {"alice", "🔄 Syncing", "2", "0", "12.5%/256MB", "1000.00 ETH", "1"},
+		"bob":       {"bob", "🔄 Syncing", "2", "0", "12.5%/256MB", "1000.00 ETH", "1"},
+		"cassandra": {"cassandra", "❌ Offline", "N/A", "N/A", "N/A", "N/A", "N/A"},
+	}
+	for _, row := range fb.rows {
+		// La balance d'Alice a payé le déploiement : seule la partie entière est comparée
+		if row[0] == "alice" && strings.HasPrefix(row[5], "999.") {
+			row[5] = "1000.00 ETH"
+		}
+		if got, expected := strings.Join(row, " | "), strings.Join(want[row[0]], " | "); got != expected {
+			t.Errorf("row %s:\n got  %s\n want %s", row[0], got, expected)
+		}
+	}
+
+	if balance := network.Nodes[0].TokenBalance[contracts.BYTokenSymbol]; balance == nil || balance.Cmp(contracts.ToTokenUnits(1000)) != 0 {
+		t.Errorf("alice BY balance: %v, want %v", balance, contracts.ToTokenUnits(1000))
+	}
+}
+
+func TestMonitorNetworkQueryTimeout(t *testing.T) {
+	client, _, network := newMonitoredNetwork(t)
+	stalled := &stalledNode{SimulatedClient: client, nodeURL: fmt.Sprintf("http://localhost:%d", network.Nodes[1].RPCPort)}
+
+	fb := &fakeFeedback{}
+	start := time.Now()
+	if err := newMonitorUseCase(network, stalled, fb, 100*time.Millisecond).Execute(context.Background(), 0); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("a stalled node held the table for %s", elapsed)
+	}
+
+	statuses := make(map[string]string)
+	for _, row := range fb.rows {
+		statuses[row[0]] = row[1]
+	}
+	if statuses["alice"] != "🔄 Syncing" || statuses["bob"] != "❌ Offline" {
+		t.Errorf("statuses: %v, want alice syncing and bob offline", statuses)
+	}
+}
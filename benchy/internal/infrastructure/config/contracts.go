@@ -0,0 +1,111 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployedContract décrit un contrat déployé par benchy, avec son ABI pour les appels suivants
+type DeployedContract struct {
+	Address    common.Address  `json:"address"`
+	ABI        json.RawMessage `json:"abi"`
+	Artifact   string          `json:"artifact"`
+	Deployer   common.Address  `json:"deployer"`
+	TxHash     common.Hash     `json:"tx_hash"`
+	Block      uint64          `json:"block"`
+	DeployedAt time.Time       `json:"deployed_at"`
+}
+
+// ContractRegistry mémorise les contrats déployés sur le réseau, par nom
+type ContractRegistry struct {
+	path      string
+	Contracts map[string]DeployedContract `json:"contracts"`
+}
+
+// LoadContractRegistry charge le registre des contrats (vide s'il n'existe pas encore)
+func LoadContractRegistry(baseDir string) (*ContractRegistry, error) {
+	registry := &ContractRegistry{
+		path:      filepath.Join(baseDir, "contracts.json"),
+		Contracts: make(map[string]DeployedContract),
+	}
+
+	data, err := os.ReadFile(registry.path)
+	if os.IsNotExist(err) {
+		return registry, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read contract registry: %w", err)
+	}
+
+	if err := json.Unmarshal(data, registry); err != nil {
+		return nil, fmt.Errorf("failed to parse contract registry: %w", err)
+	}
+	if registry.Contracts == nil {
+		registry.Contracts = make(map[string]DeployedContract)
+	}
+
+	return registry, nil
+}
+
+// Register enregistre (ou remplace) un contrat sous un nom
+func (cr *ContractRegistry) Register(name string, contract DeployedContract) {
+	cr.Contracts[name] = contract
+}
+
+// Get retourne un contrat par nom, sans tenir compte de la casse
+func (cr *ContractRegistry) Get(name string) (string, DeployedContract, bool) {
+	if contract, exists := cr.Contracts[name]; exists {
+		return name, contract, true
+	}
+	for registered, contract := range cr.Contracts {
+		if strings.EqualFold(registered, name) {
+			return registered, contract, true
+		}
+	}
+	return "", DeployedContract{}, false
+}
+
+// FindByAddress retourne le contrat déployé à une adresse
+func (cr *ContractRegistry) FindByAddress(address common.Address) (string, DeployedContract, bool) {
+	for name, contract := range cr.Contracts {
+		if contract.Address == address {
+			return name, contract, true
+		}
+	}
+	return "", DeployedContract{}, false
+}
+
+// Names retourne les noms enregistrés, triés
+func (cr *ContractRegistry) Names() []string {
+	names := make([]string, 0, len(cr.Contracts))
+	for name := range cr.Contracts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Save écrit le registre sur disque
+func (cr *ContractRegistry) Save() error {
+	if err := os.MkdirAll(filepath.Dir(cr.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(cr, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal contract registry: %w", err)
+	}
+
+	if err := os.WriteFile(cr.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write contract registry: %w", err)
+	}
+
+	return nil
+}
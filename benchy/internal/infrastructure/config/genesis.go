@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -125,6 +126,21 @@ func (g *GenesisGenerator) SaveGenesisToFile(genesis *core.Genesis, filePath str
 	return nil
 }
 
+// LoadGenesis lit le genesis.json généré avec les clés des nodes
+func LoadGenesis(baseDir string) (*core.Genesis, error) {
+	genesisJSON, err := os.ReadFile(filepath.Join(baseDir, "genesis.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis file: %w", err)
+	}
+
+	genesis := new(core.Genesis)
+	if err := json.Unmarshal(genesisJSON, genesis); err != nil {
+		return nil, fmt.Errorf("failed to parse genesis file: %w", err)
+	}
+
+	return genesis, nil
+}
+
 // KeyPair représente une paire de clé privée/publique
 type KeyPair struct {
 	PrivateKey *ecdsa.PrivateKey
@@ -149,52 +165,91 @@ func GenerateKeyPair() (*KeyPair, error) {
 	}, nil
 }
 
-// SaveKeyPairToFile sauvegarde la clé privée dans un fichier
-func (kp *KeyPair) SaveKeyPairToFile(keyDir string, name string) error {
+// SaveKeyPairToFile chiffre la clé privée dans un keystore V3 (Web3 Secret Storage) protégé par passphrase
+func (kp *KeyPair) SaveKeyPairToFile(keyDir string, name string, passphrase string) error {
 	// Créer le répertoire si nécessaire
 	if err := os.MkdirAll(keyDir, 0755); err != nil {
 		return fmt.Errorf("failed to create key directory: %w", err)
 	}
 	
-	// Sauvegarder la clé privée
-	privateKeyBytes := crypto.FromECDSA(kp.PrivateKey)
-	privateKeyPath := filepath.Join(keyDir, fmt.Sprintf("%s-private.key", name))
+	keyJSON, err := kp.EncryptKeystore(passphrase)
+	if err != nil {
+		return err
+	}
 	
-	if err := os.WriteFile(privateKeyPath, privateKeyBytes, 0600); err != nil {
-		return fmt.Errorf("failed to save private key: %w", err)
+	if err := os.WriteFile(KeystoreFilePath(keyDir, name), keyJSON, 0600); err != nil {
+		return fmt.Errorf("failed to save keystore: %w", err)
 	}
 	
-	// Sauvegarder l'adresse
+	// Sauvegarder l'adresse, lisible sans passphrase
 	addressPath := filepath.Join(keyDir, fmt.Sprintf("%s-address.txt", name))
 	if err := os.WriteFile(addressPath, []byte(kp.Address.Hex()), 0644); err != nil {
 		return fmt.Errorf("failed to save address: %w", err)
 	}
 	
+	// La clé brute des versions précédentes n'a plus lieu d'exister
+	if err := os.Remove(legacyKeyFilePath(keyDir, name)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove raw private key: %w", err)
+	}
+	
 	return nil
 }
 
-// LoadKeyPairFromFile charge une paire de clés depuis un fichier
-func LoadKeyPairFromFile(keyDir string, name string) (*KeyPair, error) {
-	privateKeyPath := filepath.Join(keyDir, fmt.Sprintf("%s-private.key", name))
+// LoadKeyPairFromFile déchiffre le keystore V3 d'un compte (ou lit l'ancienne clé brute s'il n'a pas été migré)
+func LoadKeyPairFromFile(keyDir string, name string, passphrase string) (*KeyPair, error) {
+	keyJSON, err := os.ReadFile(KeystoreFilePath(keyDir, name))
+	if os.IsNotExist(err) {
+		return loadLegacyKeyPair(keyDir, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
+	}
 	
-	// Lire la clé privée
-	privateKeyBytes, err := os.ReadFile(privateKeyPath)
+	keyPair, err := DecryptKeystore(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	
+	return keyPair, nil
+}
+
+// loadLegacyKeyPair lit une clé privée brute (<name>-private.key), format des versions précédentes
+func loadLegacyKeyPair(keyDir string, name string) (*KeyPair, error) {
+	privateKeyBytes, err := os.ReadFile(legacyKeyFilePath(keyDir, name))
 	if err != nil {
-		return nil, fmt.Errorf("failed to read private key: %w", err)
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
 	}
 	
-	// Convertir en clé ECDSA
 	privateKey, err := crypto.ToECDSA(privateKeyBytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse private key: %w", err)
 	}
 	
-	publicKey := &privateKey.PublicKey
-	address := crypto.PubkeyToAddress(*publicKey)
-	
+	return NewKeyPair(privateKey), nil
+}
+
+// NewKeyPair construit la paire de clés d'une clé privée existante
+func NewKeyPair(privateKey *ecdsa.PrivateKey) *KeyPair {
 	return &KeyPair{
 		PrivateKey: privateKey,
-		PublicKey:  publicKey,
-		Address:    address,
-	}, nil
+		PublicKey:  &privateKey.PublicKey,
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+}
+
+// LoadAddressFromFile charge l'adresse d'un node sauvegardée par SaveKeyPairToFile
+func LoadAddressFromFile(keyDir string, name string) (common.Address, error) {
+	addressPath := filepath.Join(keyDir, fmt.Sprintf("%s-address.txt", name))
+
+	data, err := os.ReadFile(addressPath)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read address: %w", err)
+	}
+
+	address := strings.TrimSpace(string(data))
+	if !common.IsHexAddress(address) {
+		return common.Address{}, fmt.Errorf("invalid address in %s", addressPath)
+	}
+
+	return common.HexToAddress(address), nil
 }
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// bip32HardenedOffset marque un index dérivé en mode durci (notation ')
+const bip32HardenedOffset = 0x80000000
+
+// GenerateMnemonic crée une nouvelle phrase mnémonique BIP-39 de 12 mots
+func GenerateMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(128)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate entropy: %w", err)
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// NormalizeMnemonic valide une phrase mnémonique et normalise ses espaces
+func NormalizeMnemonic(mnemonic string) (string, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+	if !bip39.IsMnemonicValid(normalized) {
+		return "", fmt.Errorf("invalid BIP-39 mnemonic (unknown word or bad checksum)")
+	}
+	return normalized, nil
+}
+
+// AccountDerivationPath retourne le chemin BIP-44 Ethereum du compte d'index donné (m/44'/60'/0'/0/index)
+func AccountDerivationPath(index uint32) accounts.DerivationPath {
+	path := make(accounts.DerivationPath, len(accounts.DefaultBaseDerivationPath))
+	copy(path, accounts.DefaultBaseDerivationPath)
+	path[len(path)-1] = index
+	return path
+}
+
+// DeriveKeyPair dérive la clé d'un chemin BIP-32 depuis une phrase mnémonique BIP-39 (sans passphrase),
+// comme MetaMask, Hardhat ou anvil
+func DeriveKeyPair(mnemonic string, path accounts.DerivationPath) (*KeyPair, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	// Clé maîtresse : HMAC-SHA512("Bitcoin seed", seed)
+	key, chainCode := splitHMAC([]byte("Bitcoin seed"), seed)
+	if err := checkPrivateKey(key); err != nil {
+		return nil, err
+	}
+
+	for _, index := range path {
+		if key, chainCode, err = deriveChild(key, chainCode, index); err != nil {
+			return nil, fmt.Errorf("failed to derive %s: %w", path, err)
+		}
+	}
+
+	privateKey, err := crypto.ToECDSA(key.FillBytes(make([]byte, 32)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive %s: %w", path, err)
+	}
+
+	return NewKeyPair(privateKey), nil
+}
+
+// deriveChild calcule la clé privée enfant CKDpriv de BIP-32
+func deriveChild(key *big.Int, chainCode []byte, index uint32) (*big.Int, []byte, error) {
+	var data []byte
+	if index >= bip32HardenedOffset {
+		// Enfant durci : 0x00 || clé privée || index
+		data = append([]byte{0}, key.FillBytes(make([]byte, 32))...)
+	} else {
+		// Enfant normal : clé publique compressée || index
+		privateKey, err := crypto.ToECDSA(key.FillBytes(make([]byte, 32)))
+		if err != nil {
+			return nil, nil, err
+		}
+		data = crypto.CompressPubkey(&privateKey.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	tweak, childChainCode := splitHMAC(chainCode, data)
+	if tweak.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d", index)
+	}
+
+	child := new(big.Int).Add(tweak, key)
+	child.Mod(child, crypto.S256().Params().N)
+	if err := checkPrivateKey(child); err != nil {
+		return nil, nil, err
+	}
+
+	return child, childChainCode, nil
+}
+
+// splitHMAC calcule HMAC-SHA512 et le sépare en clé (32 premiers octets) et chain code
+func splitHMAC(key []byte, data []byte) (*big.Int, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return new(big.Int).SetBytes(sum[:32]), sum[32:]
+}
+
+// checkPrivateKey rejette les clés hors de l'intervalle ]0, n[ de secp256k1
+func checkPrivateKey(key *big.Int) error {
+	if key.Sign() == 0 || key.Cmp(crypto.S256().Params().N) >= 0 {
+		return fmt.Errorf("derived key is out of range")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// testMnemonic est la mnémonique de test de Hardhat et anvil
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func TestDeriveKeyPairStandardVectors(t *testing.T) {
+	// Comptes affichés par Hardhat et anvil pour la mnémonique de test
+	for index, want := range []string{
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		"0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+	} {
+		keyPair, err := DeriveKeyPair(testMnemonic, AccountDerivationPath(uint32(index)))
+		if err != nil {
+			t.Fatalf("DeriveKeyPair(m/44'/60'/0'/0/%d): %v", index, err)
+		}
+		if keyPair.Address != common.HexToAddress(want) {
+			t.Errorf("m/44'/60'/0'/0/%d: %s, want %s", index, keyPair.Address.Hex(), want)
+		}
+	}
+}
+
+func TestDeriveKeyPairInvalidMnemonic(t *testing.T) {
+	if _, err := DeriveKeyPair("test test test test test test test test test test test test", AccountDerivationPath(0)); err == nil {
+		t.Fatal("mnemonic with a bad checksum: want an error")
+	}
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+func TestEncryptKeystoreStandardScrypt(t *testing.T) {
+	keyPair, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair: %v", err)
+	}
+
+	keyJSON, err := keyPair.EncryptKeystore("benchy")
+	if err != nil {
+		t.Fatalf("EncryptKeystore: %v", err)
+	}
+
+	var stored struct {
+		Crypto struct {
+			KDF       string `json:"kdf"`
+			KDFParams struct {
+				N int `json:"n"`
+				P int `json:"p"`
+			} `json:"kdfparams"`
+		} `json:"crypto"`
+	}
+	if err := json.Unmarshal(keyJSON, &stored); err != nil {
+		t.Fatalf("keystore JSON: %v", err)
+	}
+	if params := stored.Crypto.KDFParams; stored.Crypto.KDF != "scrypt" || params.N != keystore.StandardScryptN || params.P != keystore.StandardScryptP {
+		t.Fatalf("kdf %s n=%d p=%d, want scrypt n=%d p=%d", stored.Crypto.KDF, params.N, params.P, keystore.StandardScryptN, keystore.StandardScryptP)
+	}
+
+	decrypted, err := DecryptKeystore(keyJSON, "benchy")
+	if err != nil {
+		t.Fatalf("DecryptKeystore: %v", err)
+	}
+	if decrypted.Address != keyPair.Address {
+		t.Fatalf("decrypted %s, want %s", decrypted.Address.Hex(), keyPair.Address.Hex())
+	}
+	if _, err := DecryptKeystore(keyJSON, "wrong"); err == nil {
+		t.Fatal("DecryptKeystore with a wrong passphrase: want an error")
+	}
+}
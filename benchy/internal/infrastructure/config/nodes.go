@@ -5,17 +5,27 @@ import (
 	"math/big"
 	"github.com/ethereum/go-ethereum/core"
 	"path/filepath"
+	"strings"
 
 	"benchy/internal/domain/entities"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // NodeConfigManager gère la configuration des nodes
 type NodeConfigManager struct {
-	baseDir string
-	nodes   []*NodeConfig
+	baseDir       string
+	passphrase    string // Passphrase des keystores V3 des nodes
+	mnemonic      string // Phrase BIP-39 dont dérivent les clés (vide = clés aléatoires)
+	extraAccounts int
+	overwriteKeys bool // La mnémonique peut remplacer des clés enregistrées qu'elle ne dérive pas
+	nodes         []*NodeConfig
+	accounts      []*KeyPair // Comptes de test supplémentaires, financés dans le genesis
 }
 
+// extraAccountBalance est l'allocation genesis de chaque compte de test supplémentaire (100 ETH)
+var extraAccountBalance = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+
 // NodeConfig représente la configuration complète d'un node
 type NodeConfig struct {
 	Name        string
@@ -37,6 +47,54 @@ func NewNodeConfigManager(baseDir string) *NodeConfigManager {
 	}
 }
 
+// SetPassphrase choisit la passphrase qui chiffre les keystores des nodes
+func (ncm *NodeConfigManager) SetPassphrase(passphrase string) {
+	ncm.passphrase = passphrase
+}
+
+// SetMnemonic dérive les clés des nodes (index 0 à 4) puis de extraAccounts comptes de test
+// (index 5 et suivants) depuis une phrase mnémonique, le long de m/44'/60'/0'/0/index
+func (ncm *NodeConfigManager) SetMnemonic(mnemonic string, extraAccounts int) error {
+	if mnemonic != "" {
+		normalized, err := NormalizeMnemonic(mnemonic)
+		if err != nil {
+			return err
+		}
+		mnemonic = normalized
+	}
+	if extraAccounts < 0 {
+		return fmt.Errorf("invalid number of extra accounts: %d", extraAccounts)
+	}
+
+	ncm.mnemonic = mnemonic
+	ncm.extraAccounts = extraAccounts
+	return nil
+}
+
+// AllowKeyOverwrite autorise la mnémonique à remplacer les clés enregistrées qu'elle ne dérive pas
+func (ncm *NodeConfigManager) AllowKeyOverwrite() {
+	ncm.overwriteKeys = true
+}
+
+// KeyConflictError signale des comptes dont la clé enregistrée serait remplacée par celle de la mnémonique
+type KeyConflictError struct {
+	Names []string
+}
+
+func (e *KeyConflictError) Error() string {
+	return fmt.Sprintf("the mnemonic would replace the stored keys of %s", strings.Join(e.Names, ", "))
+}
+
+// ExtraAccountName retourne le nom du compte de test supplémentaire d'index donné (account1, account2…)
+func ExtraAccountName(index int) string {
+	return fmt.Sprintf("account%d", index+1)
+}
+
+// NodeKeystoreDir retourne le répertoire des clés d'un node
+func NodeKeystoreDir(baseDir string, name string) string {
+	return filepath.Join(baseDir, "nodes", name, "keystore")
+}
+
 // GenerateDefaultNodes génère la configuration des 5 nodes par défaut
 func (ncm *NodeConfigManager) GenerateDefaultNodes() error {
 	defaultNodes := []struct {
@@ -53,9 +111,10 @@ func (ncm *NodeConfigManager) GenerateDefaultNodes() error {
 		{"elena", false, entities.ClientNethermind, 30307, 8549},
 	}
 
-	for _, nodeInfo := range defaultNodes {
-		// Générer la paire de clés
-		keyPair, err := GenerateKeyPair()
+	ncm.nodes = ncm.nodes[:0]
+	for index, nodeInfo := range defaultNodes {
+		// Dériver (mnémonique) ou générer la paire de clés
+		keyPair, err := ncm.newKeyPair(index)
 		if err != nil {
 			return fmt.Errorf("failed to generate key pair for %s: %w", nodeInfo.name, err)
 		}
@@ -70,15 +129,54 @@ func (ncm *NodeConfigManager) GenerateDefaultNodes() error {
 			WSPort:      nodeInfo.rpcPort + 1000, // WebSocket port = RPC port + 1000
 			KeyPair:     keyPair,
 			DataDir:     filepath.Join(ncm.baseDir, "nodes", nodeInfo.name, "data"),
-			KeystoreDir: filepath.Join(ncm.baseDir, "nodes", nodeInfo.name, "keystore"),
+			KeystoreDir: NodeKeystoreDir(ncm.baseDir, nodeInfo.name),
 		}
 
 		ncm.nodes = append(ncm.nodes, nodeConfig)
 	}
 
+	ncm.accounts = ncm.accounts[:0]
+	for i := 0; i < ncm.extraAccounts; i++ {
+		keyPair, err := ncm.newKeyPair(len(defaultNodes) + i)
+		if err != nil {
+			return fmt.Errorf("failed to generate key pair for %s: %w", ExtraAccountName(i), err)
+		}
+		ncm.accounts = append(ncm.accounts, keyPair)
+	}
+
 	return nil
 }
 
+// mnemonicConflicts retourne les comptes dont une clé est enregistrée à une autre adresse que celle dérivée
+// de la mnémonique
+func (ncm *NodeConfigManager) mnemonicConflicts() []string {
+	var conflicts []string
+	conflicting := func(keyDir string, name string, derived common.Address) {
+		if !KeyExists(keyDir, name) {
+			return
+		}
+		if stored, err := LoadAddressFromFile(keyDir, name); err != nil || stored != derived {
+			conflicts = append(conflicts, name)
+		}
+	}
+
+	for _, node := range ncm.nodes {
+		conflicting(node.KeystoreDir, node.Name, node.KeyPair.Address)
+	}
+	for i, account := range ncm.accounts {
+		conflicting(AccountsKeystoreDir(ncm.baseDir), ExtraAccountName(i), account.Address)
+	}
+	return conflicts
+}
+
+// newKeyPair dérive la clé d'index donné depuis la mnémonique, ou en génère une aléatoire
+func (ncm *NodeConfigManager) newKeyPair(index int) (*KeyPair, error) {
+	if ncm.mnemonic == "" {
+		return GenerateKeyPair()
+	}
+	return DeriveKeyPair(ncm.mnemonic, AccountDerivationPath(uint32(index)))
+}
+
 // SaveAllConfigurations sauvegarde toutes les configurations
 func (ncm *NodeConfigManager) SaveAllConfigurations() error {
 	for _, node := range ncm.nodes {
@@ -86,14 +184,26 @@ func (ncm *NodeConfigManager) SaveAllConfigurations() error {
 			return fmt.Errorf("failed to save configuration for %s: %w", node.Name, err)
 		}
 	}
-	return nil
+
+	for i, account := range ncm.accounts {
+		if err := account.SaveKeyPairToFile(AccountsKeystoreDir(ncm.baseDir), ExtraAccountName(i), ncm.passphrase); err != nil {
+			return fmt.Errorf("failed to save key pair for %s: %w", ExtraAccountName(i), err)
+		}
+	}
+
+	// Le genesis suit les clés : même mnémonique, mêmes allocations
+	genesis, err := ncm.GenerateGenesisWithNodes()
+	if err != nil {
+		return err
+	}
+	return NewGenesisGenerator().SaveGenesisToFile(genesis, filepath.Join(ncm.baseDir, "genesis.json"))
 }
 
 // saveNodeConfiguration sauvegarde la configuration d'un node
 func (ncm *NodeConfigManager) saveNodeConfiguration(node *NodeConfig) error {
 	
-	// Sauvegarder la paire de clés
-	if err := node.KeyPair.SaveKeyPairToFile(node.KeystoreDir, node.Name); err != nil {
+	// Sauvegarder la paire de clés dans un keystore V3
+	if err := node.KeyPair.SaveKeyPairToFile(node.KeystoreDir, node.Name, ncm.passphrase); err != nil {
 		return fmt.Errorf("failed to save key pair: %w", err)
 	}
 
@@ -120,6 +230,11 @@ func (ncm *NodeConfigManager) GetAllAddresses() []common.Address {
 	return addresses
 }
 
+// GetExtraAccounts retourne les comptes de test supplémentaires
+func (ncm *NodeConfigManager) GetExtraAccounts() []*KeyPair {
+	return ncm.accounts
+}
+
 // GetNodeByName retourne la configuration d'un node par son nom
 func (ncm *NodeConfigManager) GetNodeByName(name string) *NodeConfig {
 	for _, node := range ncm.nodes {
@@ -156,12 +271,53 @@ func (ncm *NodeConfigManager) GenerateGenesisWithNodes() (*core.Genesis, error)
 		}
 	}
 	
+	for _, account := range ncm.accounts {
+		generator.AddAllocation(account.Address, extraAccountBalance)
+	}
+	
 	return generator.GenerateGenesis()
 }
 
-// LoadExistingConfigurations charge les configurations existantes
+// LoadExistingConfigurations reprend les clés déjà enregistrées des nodes, pour garder les mêmes
+// adresses d'un lancement à l'autre. Avec une mnémonique, ou sans clés complètes, elles sont (re)générées ;
+// une mnémonique qui ne dérive pas les clés enregistrées retourne un *KeyConflictError, sauf après
+// AllowKeyOverwrite.
 func (ncm *NodeConfigManager) LoadExistingConfigurations() error {
-	// Pour l'instant, on génère toujours de nouvelles configurations
-	// TODO: Implémenter le chargement depuis des fichiers existants
-	return ncm.GenerateDefaultNodes()
+	if err := ncm.GenerateDefaultNodes(); err != nil {
+		return err
+	}
+	if ncm.mnemonic != "" {
+		if conflicts := ncm.mnemonicConflicts(); len(conflicts) > 0 && !ncm.overwriteKeys {
+			return &KeyConflictError{Names: conflicts}
+		}
+		return nil
+	}
+
+	loaded := make([]*KeyPair, len(ncm.nodes))
+	for i, node := range ncm.nodes {
+		if !KeyExists(node.KeystoreDir, node.Name) {
+			// Réseau jamais configuré (ou incomplet) : on garde les nouvelles clés
+			return nil
+		}
+		keyPair, err := LoadKeyPairFromFile(node.KeystoreDir, node.Name, ncm.passphrase)
+		if err != nil {
+			return err
+		}
+		loaded[i] = keyPair
+	}
+
+	for i, node := range ncm.nodes {
+		node.KeyPair = loaded[i]
+	}
+	for i := range ncm.accounts {
+		if !KeyExists(AccountsKeystoreDir(ncm.baseDir), ExtraAccountName(i)) {
+			continue
+		}
+		keyPair, err := LoadKeyPairFromFile(AccountsKeystoreDir(ncm.baseDir), ExtraAccountName(i), ncm.passphrase)
+		if err != nil {
+			return err
+		}
+		ncm.accounts[i] = keyPair
+	}
+	return nil
 }
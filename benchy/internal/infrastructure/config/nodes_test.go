@@ -0,0 +1,55 @@
+package config
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLoadExistingConfigurationsMnemonicConflict(t *testing.T) {
+	baseDir := t.TempDir()
+
+	// Alice a déjà une clé aléatoire
+	stored, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair: %v", err)
+	}
+	if err := stored.SaveKeyPairToFile(NodeKeystoreDir(baseDir, "alice"), "alice", "benchy"); err != nil {
+		t.Fatalf("SaveKeyPairToFile: %v", err)
+	}
+
+	manager := NewNodeConfigManager(baseDir)
+	manager.SetPassphrase("benchy")
+	if err := manager.SetMnemonic(testMnemonic, 0); err != nil {
+		t.Fatalf("SetMnemonic: %v", err)
+	}
+
+	var conflict *KeyConflictError
+	if err := manager.LoadExistingConfigurations(); !errors.As(err, &conflict) {
+		t.Fatalf("LoadExistingConfigurations: %v, want a *KeyConflictError", err)
+	}
+	if len(conflict.Names) != 1 || conflict.Names[0] != "alice" {
+		t.Fatalf("conflicts: %v, want [alice]", conflict.Names)
+	}
+
+	manager.AllowKeyOverwrite()
+	if err := manager.LoadExistingConfigurations(); err != nil {
+		t.Fatalf("LoadExistingConfigurations after AllowKeyOverwrite: %v", err)
+	}
+	alice := manager.GetNodeByName("alice")
+	if alice.KeyPair.Address == stored.Address {
+		t.Fatal("alice kept the stored key, want the derived one")
+	}
+
+	// La clé que la mnémonique dérive déjà n'est pas un conflit : relancer avec la même mnémonique
+	if err := alice.KeyPair.SaveKeyPairToFile(alice.KeystoreDir, "alice", "benchy"); err != nil {
+		t.Fatalf("SaveKeyPairToFile: %v", err)
+	}
+	relaunch := NewNodeConfigManager(baseDir)
+	relaunch.SetPassphrase("benchy")
+	if err := relaunch.SetMnemonic(testMnemonic, 0); err != nil {
+		t.Fatalf("SetMnemonic: %v", err)
+	}
+	if err := relaunch.LoadExistingConfigurations(); err != nil {
+		t.Fatalf("relaunch with the same mnemonic: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TxSightings mémorise quand benchy a vu chaque transaction du mempool pour la première fois :
+// les nodes n'exposent pas l'heure d'arrivée d'une transaction
+type TxSightings struct {
+	path      string
+	FirstSeen map[common.Hash]time.Time `json:"first_seen"`
+}
+
+// LoadTxSightings charge les observations précédentes (vides si le fichier n'existe pas encore)
+func LoadTxSightings(baseDir string) (*TxSightings, error) {
+	sightings := &TxSightings{
+		path:      filepath.Join(baseDir, "mempool.json"),
+		FirstSeen: make(map[common.Hash]time.Time),
+	}
+
+	data, err := os.ReadFile(sightings.path)
+	if os.IsNotExist(err) {
+		return sightings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mempool sightings: %w", err)
+	}
+
+	if err := json.Unmarshal(data, sightings); err != nil {
+		return nil, fmt.Errorf("failed to parse mempool sightings: %w", err)
+	}
+	if sightings.FirstSeen == nil {
+		sightings.FirstSeen = make(map[common.Hash]time.Time)
+	}
+
+	return sightings, nil
+}
+
+// See enregistre une observation et retourne l'heure de la première
+func (ts *TxSightings) See(hash common.Hash, now time.Time) time.Time {
+	if first, exists := ts.FirstSeen[hash]; exists {
+		return first
+	}
+	ts.FirstSeen[hash] = now
+	return now
+}
+
+// Retain oublie les transactions qui ne sont plus dans le mempool
+func (ts *TxSightings) Retain(hashes map[common.Hash]bool) {
+	for hash := range ts.FirstSeen {
+		if !hashes[hash] {
+			delete(ts.FirstSeen, hash)
+		}
+	}
+}
+
+// Save écrit les observations sur disque
+func (ts *TxSightings) Save() error {
+	if err := os.MkdirAll(filepath.Dir(ts.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(ts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal mempool sightings: %w", err)
+	}
+
+	if err := os.WriteFile(ts.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write mempool sightings: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TokenRegistry mémorise les adresses des tokens déployés sur le réseau
+type TokenRegistry struct {
+	path   string
+	Tokens map[string]common.Address `json:"tokens"`
+}
+
+// LoadTokenRegistry charge le registre des tokens (vide s'il n'existe pas encore)
+func LoadTokenRegistry(baseDir string) (*TokenRegistry, error) {
+	registry := &TokenRegistry{
+		path:   filepath.Join(baseDir, "tokens.json"),
+		Tokens: make(map[string]common.Address),
+	}
+
+	data, err := os.ReadFile(registry.path)
+	if os.IsNotExist(err) {
+		return registry, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token registry: %w", err)
+	}
+
+	if err := json.Unmarshal(data, registry); err != nil {
+		return nil, fmt.Errorf("failed to parse token registry: %w", err)
+	}
+	if registry.Tokens == nil {
+		registry.Tokens = make(map[string]common.Address)
+	}
+
+	return registry, nil
+}
+
+// Register enregistre l'adresse d'un token par son symbole
+func (tr *TokenRegistry) Register(symbol string, address common.Address) {
+	tr.Tokens[strings.ToUpper(symbol)] = address
+}
+
+// Get retourne l'adresse d'un token par son symbole
+func (tr *TokenRegistry) Get(symbol string) (common.Address, bool) {
+	address, exists := tr.Tokens[strings.ToUpper(symbol)]
+	return address, exists
+}
+
+// Symbols retourne les symboles enregistrés, triés
+func (tr *TokenRegistry) Symbols() []string {
+	symbols := make([]string, 0, len(tr.Tokens))
+	for symbol := range tr.Tokens {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Save écrit le registre sur disque
+func (tr *TokenRegistry) Save() error {
+	if err := os.MkdirAll(filepath.Dir(tr.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(tr, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal token registry: %w", err)
+	}
+
+	if err := os.WriteFile(tr.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write token registry: %w", err)
+	}
+
+	return nil
+}
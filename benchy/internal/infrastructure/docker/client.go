@@ -0,0 +1,513 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
+)
+
+// failedContainerLogLines est le nombre de lignes de logs jointes à l'erreur d'un container en échec
+const failedContainerLogLines = 5
+
+// DockerClient implémente ports.DockerService avec le SDK du Docker Engine (API HTTP du daemon)
+type DockerClient struct {
+	client   *client.Client
+	feedback ports.FeedbackService
+	stats    *StatsCollector
+
+	negotiation sync.Once
+}
+
+// NewDockerClient crée un client configuré par l'environnement (DOCKER_HOST, DOCKER_CERT_PATH...).
+// La connexion au daemon n'est établie qu'au premier appel : ErrDaemonUnavailable signale son absence.
+func NewDockerClient(feedback ports.FeedbackService) (*DockerClient, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+
+	dc := &DockerClient{
+		client:   cli,
+		feedback: feedback,
+	}
+	dc.stats = NewStatsCollector(dc)
+
+	return dc, nil
+}
+
+// Close ferme les flux de statistiques et libère les connexions au daemon
+func (dc *DockerClient) Close() error {
+	dc.stats.Close()
+	return dc.client.Close()
+}
+
+// negotiateAPIVersion fixe la version d'API avec le daemon avant des appels concurrents : le SDK la
+// négocie sinon paresseusement à chaque premier appel, sans synchronisation
+func (dc *DockerClient) negotiateAPIVersion(ctx context.Context) {
+	dc.negotiation.Do(func() {
+		dc.client.NegotiateAPIVersion(ctx)
+	})
+}
+
+// StatsCollector retourne le collecteur qui garde un flux de statistiques ouvert par container
+func (dc *DockerClient) StatsCollector() *StatsCollector {
+	return dc.stats
+}
+
+// ServerVersion retourne la version du daemon Docker, et vérifie au passage qu'il répond
+func (dc *DockerClient) ServerVersion(ctx context.Context) (string, error) {
+	version, err := dc.client.ServerVersion(ctx)
+	if err != nil {
+		return "", wrapError("reach", "docker daemon", nil, err)
+	}
+	return version.Version, nil
+}
+
+// CreateContainer crée un container (sans le démarrer), après avoir téléchargé l'image si besoin
+func (dc *DockerClient) CreateContainer(ctx context.Context, node *entities.Node, config ports.ContainerConfig) (string, error) {
+	if err := dc.ensureImage(ctx, config.Image); err != nil {
+		return "", err
+	}
+
+	exposedPorts, portBindings, err := portMappings(config.Ports)
+	if err != nil {
+		return "", err
+	}
+
+	binds := make([]string, 0, len(config.Volumes))
+	for hostPath, containerPath := range config.Volumes {
+		binds = append(binds, fmt.Sprintf("%s:%s", hostPath, containerPath))
+	}
+	sort.Strings(binds)
+
+	containerConfig := &container.Config{
+		Image:        config.Image,
+		Cmd:          config.Command,
+		Env:          config.Environment,
+		ExposedPorts: exposedPorts,
+		Labels:       config.Labels,
+	}
+
+	hostConfig := &container.HostConfig{
+		PortBindings: portBindings,
+		Binds:        binds,
+		CapAdd:       config.CapAdd,
+	}
+
+	// Un container qui partage l'espace réseau d'un autre n'a pas de point d'accès propre
+	networkConfig := &network.NetworkingConfig{}
+	if config.NetworkMode != "" {
+		hostConfig.NetworkMode = container.NetworkMode(config.NetworkMode)
+	}
+	if config.NetworkMode != "" && !hostConfig.NetworkMode.IsContainer() {
+		networkConfig.EndpointsConfig = map[string]*network.EndpointSettings{
+			config.NetworkMode: {},
+		}
+	}
+
+	resp, err := dc.client.ContainerCreate(ctx, containerConfig, hostConfig, networkConfig, nil, config.Name)
+	if err != nil {
+		return "", wrapError("create container", config.Name, ErrImageNotFound, err)
+	}
+
+	return resp.ID, nil
+}
+
+// portMappings convertit les ports host:container (TCP par défaut, "30303/udp" accepté) en ports exposés et
+// publiés ; un port de l'hôte sans adresse ("8545") écoute sur toutes les interfaces
+func portMappings(mappings map[string]string) (nat.PortSet, nat.PortMap, error) {
+	exposedPorts := make(nat.PortSet)
+	portBindings := make(nat.PortMap)
+
+	for hostPort, containerPort := range mappings {
+		proto, port := nat.SplitProtoPort(containerPort)
+		natPort, err := nat.NewPort(proto, port)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid container port %s: %w", containerPort, err)
+		}
+
+		hostIP := "0.0.0.0"
+		if ip, port, found := strings.Cut(hostPort, ":"); found {
+			hostIP, hostPort = ip, port
+		}
+
+		exposedPorts[natPort] = struct{}{}
+		portBindings[natPort] = append(portBindings[natPort], nat.PortBinding{
+			HostIP:   hostIP,
+			HostPort: hostPort,
+		})
+	}
+
+	return exposedPorts, portBindings, nil
+}
+
+// StartContainer démarre un container créé ou arrêté
+func (dc *DockerClient) StartContainer(ctx context.Context, containerID string) error {
+	if err := dc.client.ContainerStart(ctx, containerID, types.ContainerStartOptions{}); err != nil {
+		return wrapError("start container", containerID, ErrContainerNotFound, err)
+	}
+	return nil
+}
+
+// RunContainer crée un container, attend sa fin puis le supprime (équivalent de docker run --rm).
+// Un code de sortie non nul retourne ErrContainerFailed avec les dernières lignes de logs.
+func (dc *DockerClient) RunContainer(ctx context.Context, config ports.ContainerConfig) error {
+	containerID, err := dc.CreateContainer(ctx, nil, config)
+	if err != nil {
+		return err
+	}
+	defer dc.client.ContainerRemove(context.Background(), containerID, types.ContainerRemoveOptions{Force: true})
+
+	// Attendre avant de démarrer : un container très court pourrait sinon finir avant l'abonnement
+	statusCh, errCh := dc.client.ContainerWait(ctx, containerID, container.WaitConditionNextExit)
+
+	if err := dc.StartContainer(ctx, containerID); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-errCh:
+		return wrapError("wait for container", config.Name, ErrContainerNotFound, err)
+	case status := <-statusCh:
+		if status.StatusCode == 0 {
+			return nil
+		}
+		lines, _ := dc.GetContainerLogs(ctx, containerID, failedContainerLogLines)
+		return &OperationError{
+			Op:     "run container",
+			Target: config.Name,
+			Kind:   ErrContainerFailed,
+			Err:    fmt.Errorf("exit code %d: %s", status.StatusCode, strings.Join(lines, " | ")),
+		}
+	}
+}
+
+// StopContainer arrête un container (SIGTERM, puis SIGKILL après le délai par défaut du daemon)
+func (dc *DockerClient) StopContainer(ctx context.Context, containerID string) error {
+	if err := dc.client.ContainerStop(ctx, containerID, container.StopOptions{}); err != nil {
+		return wrapError("stop container", containerID, ErrContainerNotFound, err)
+	}
+	return nil
+}
+
+// RestartContainer redémarre un container
+func (dc *DockerClient) RestartContainer(ctx context.Context, containerID string) error {
+	if err := dc.client.ContainerRestart(ctx, containerID, container.StopOptions{}); err != nil {
+		return wrapError("restart container", containerID, ErrContainerNotFound, err)
+	}
+	return nil
+}
+
+// RemoveContainer supprime un container, même en cours d'exécution
+func (dc *DockerClient) RemoveContainer(ctx context.Context, containerID string) error {
+	if err := dc.client.ContainerRemove(ctx, containerID, types.ContainerRemoveOptions{Force: true}); err != nil {
+		return wrapError("remove container", containerID, ErrContainerNotFound, err)
+	}
+	return nil
+}
+
+// GetContainerInfo récupère les informations d'un container
+func (dc *DockerClient) GetContainerInfo(ctx context.Context, containerID string) (*ports.ContainerInfo, error) {
+	inspect, err := dc.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, wrapError("inspect container", containerID, ErrContainerNotFound, err)
+	}
+
+	var portList []string
+	for port, bindings := range inspect.NetworkSettings.Ports {
+		for _, binding := range bindings {
+			portList = append(portList, fmt.Sprintf("%s->%s", binding.HostPort, port))
+		}
+	}
+	sort.Strings(portList)
+
+	var networkList []string
+	for networkName := range inspect.NetworkSettings.Networks {
+		networkList = append(networkList, networkName)
+	}
+	sort.Strings(networkList)
+
+	return &ports.ContainerInfo{
+		ID:       inspect.ID,
+		Name:     strings.TrimPrefix(inspect.Name, "/"),
+		Status:   inspect.State.Status,
+		Image:    inspect.Config.Image,
+		Ports:    portList,
+		Networks: networkList,
+	}, nil
+}
+
+// GetContainerLogs récupère les dernières lignes de logs d'un container (stdout et stderr)
+func (dc *DockerClient) GetContainerLogs(ctx context.Context, containerID string, tail int) ([]string, error) {
+	logs, err := dc.client.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       strconv.Itoa(tail),
+	})
+	if err != nil {
+		return nil, wrapError("get logs of", containerID, ErrContainerNotFound, err)
+	}
+	defer logs.Close()
+
+	// Sans TTY, le daemon multiplexe stdout et stderr dans un même flux
+	var output bytes.Buffer
+	if _, err := stdcopy.StdCopy(&output, &output, logs); err != nil {
+		return nil, wrapError("read logs of", containerID, ErrContainerNotFound, err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(output.String(), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+
+	return lines, nil
+}
+
+// ListContainers liste les containers, arrêtés compris, qui portent tous les labels donnés
+func (dc *DockerClient) ListContainers(ctx context.Context, labels map[string]string) ([]*ports.ContainerInfo, error) {
+	containers, err := dc.client.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: labelFilters(labels),
+	})
+	if err != nil {
+		return nil, wrapError("list containers", describeLabels(labels), ErrContainerNotFound, err)
+	}
+
+	infos := make([]*ports.ContainerInfo, 0, len(containers))
+	for _, summary := range containers {
+		info := &ports.ContainerInfo{
+			ID:     summary.ID,
+			Status: summary.Status,
+			State:  summary.State,
+			Image:  summary.Image,
+			Labels: summary.Labels,
+		}
+		if len(summary.Names) > 0 {
+			info.Name = strings.TrimPrefix(summary.Names[0], "/")
+		}
+		for _, port := range summary.Ports {
+			if port.PublicPort != 0 {
+				info.Ports = append(info.Ports, fmt.Sprintf("%d->%d/%s", port.PublicPort, port.PrivatePort, port.Type))
+			}
+		}
+		sort.Strings(info.Ports)
+		if summary.NetworkSettings != nil {
+			for networkName := range summary.NetworkSettings.Networks {
+				info.Networks = append(info.Networks, networkName)
+			}
+			sort.Strings(info.Networks)
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// labelFilters construit le filtre "label=clé=valeur" de l'API pour chaque label
+func labelFilters(labels map[string]string) filters.Args {
+	args := filters.NewArgs()
+	for key, value := range labels {
+		args.Add("label", key+"="+value)
+	}
+	return args
+}
+
+// describeLabels décrit un filtre de labels dans les erreurs (ex: "benchy.network=benchy-network")
+func describeLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// hasLabels vérifie qu'une ressource porte tous les labels donnés
+func hasLabels(resourceLabels, labels map[string]string) bool {
+	for key, value := range labels {
+		if resourceLabels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// IsContainerRunning vérifie si un container est en cours d'exécution ; un container absent n'est pas en cours
+func (dc *DockerClient) IsContainerRunning(ctx context.Context, containerID string) (bool, error) {
+	inspect, err := dc.client.ContainerInspect(ctx, containerID)
+	if errdefs.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, wrapError("inspect container", containerID, ErrContainerNotFound, err)
+	}
+
+	return inspect.State.Running, nil
+}
+
+// GetContainerStats retourne la dernière mesure du flux de statistiques du container. Le premier
+// appel ouvre le flux et attend sa première mesure ; les suivants lisent le cache sans attendre.
+func (dc *DockerClient) GetContainerStats(ctx context.Context, containerID string) (*ports.ContainerStats, error) {
+	dc.stats.Watch(containerID)
+	if stats, ok := dc.stats.WaitStats(ctx, containerID); ok {
+		return stats, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("no stats received for container %s: %w", containerID, err)
+	}
+
+	running, err := dc.IsContainerRunning(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+	if !running {
+		return nil, &OperationError{Op: "get stats of", Target: containerID, Kind: ErrContainerNotRunning, Err: errors.New("no stats stream")}
+	}
+	return nil, fmt.Errorf("stats stream of container %s ended", containerID)
+}
+
+// containerStats convertit un échantillon de l'API en statistiques benchy ; previous est la mesure
+// CPU de l'échantillon précédent du flux
+func containerStats(sample *types.StatsJSON, previous types.CPUStats) *ports.ContainerStats {
+	result := &ports.ContainerStats{
+		CPUUsage:    cpuPercent(previous, sample.CPUStats),
+		MemoryUsage: sample.MemoryStats.Usage,
+		MemoryLimit: sample.MemoryStats.Limit,
+	}
+
+	// Comme docker stats : le cache de pages n'est pas compté comme mémoire utilisée
+	if cache, ok := sample.MemoryStats.Stats["inactive_file"]; ok && cache < result.MemoryUsage {
+		result.MemoryUsage -= cache
+	}
+
+	for _, netStats := range sample.Networks {
+		result.NetworkRX += netStats.RxBytes
+		result.NetworkTX += netStats.TxBytes
+	}
+
+	for _, entry := range sample.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			result.BlockRead += entry.Value
+		case "write":
+			result.BlockWrite += entry.Value
+		}
+	}
+
+	return result
+}
+
+// cpuPercent calcule l'utilisation CPU comme docker stats : part du temps système consommée
+// par le container entre deux mesures, rapportée au nombre de CPU
+func cpuPercent(previous, current types.CPUStats) float64 {
+	cpuDelta := float64(current.CPUUsage.TotalUsage) - float64(previous.CPUUsage.TotalUsage)
+	systemDelta := float64(current.SystemUsage) - float64(previous.SystemUsage)
+
+	onlineCPUs := float64(current.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(current.CPUUsage.PercpuUsage))
+	}
+
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+	return cpuDelta / systemDelta * onlineCPUs * 100
+}
+
+// CreateNetwork crée un réseau Docker bridge avec ses labels, s'il n'existe pas déjà. Les labels d'un
+// réseau ne se modifient pas : un réseau existant sans ces labels est recréé s'il est vide, refusé sinon.
+func (dc *DockerClient) CreateNetwork(ctx context.Context, networkName string, labels map[string]string) error {
+	existing, err := dc.client.NetworkInspect(ctx, networkName, types.NetworkInspectOptions{})
+	switch {
+	case err == nil && hasLabels(existing.Labels, labels):
+		return nil
+	case err == nil && len(existing.Containers) > 0:
+		return &OperationError{
+			Op:     "create network",
+			Target: networkName,
+			Kind:   ErrNameConflict,
+			Err:    fmt.Errorf("an unlabelled network with %d containers already uses this name", len(existing.Containers)),
+		}
+	case err == nil:
+		if err := dc.RemoveNetwork(ctx, networkName); err != nil {
+			return err
+		}
+	case !errdefs.IsNotFound(err):
+		return wrapError("inspect network", networkName, ErrNetworkNotFound, err)
+	}
+
+	if _, err := dc.client.NetworkCreate(ctx, networkName, types.NetworkCreate{
+		Driver:         "bridge",
+		CheckDuplicate: true,
+		Labels:         labels,
+	}); err != nil {
+		return wrapError("create network", networkName, ErrNetworkNotFound, err)
+	}
+
+	return nil
+}
+
+// RemoveNetwork supprime un réseau Docker
+func (dc *DockerClient) RemoveNetwork(ctx context.Context, networkName string) error {
+	if err := dc.client.NetworkRemove(ctx, networkName); err != nil {
+		return wrapError("remove network", networkName, ErrNetworkNotFound, err)
+	}
+	return nil
+}
+
+// ListNetworks retourne le nom des réseaux qui portent tous les labels donnés
+func (dc *DockerClient) ListNetworks(ctx context.Context, labels map[string]string) ([]string, error) {
+	networks, err := dc.client.NetworkList(ctx, types.NetworkListOptions{Filters: labelFilters(labels)})
+	if err != nil {
+		return nil, wrapError("list networks", describeLabels(labels), ErrNetworkNotFound, err)
+	}
+
+	names := make([]string, 0, len(networks))
+	for _, resource := range networks {
+		names = append(names, resource.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ConnectToNetwork connecte un container à un réseau
+func (dc *DockerClient) ConnectToNetwork(ctx context.Context, containerID, networkName string) error {
+	if err := dc.client.NetworkConnect(ctx, networkName, containerID, nil); err != nil {
+		return wrapError("connect to "+networkName, containerID, ErrNetworkNotFound, err)
+	}
+	return nil
+}
+
+// GetContainerIP retourne l'adresse IP d'un container sur un réseau Docker
+func (dc *DockerClient) GetContainerIP(ctx context.Context, containerID, networkName string) (string, error) {
+	inspect, err := dc.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return "", wrapError("inspect container", containerID, ErrContainerNotFound, err)
+	}
+
+	endpoint, attached := inspect.NetworkSettings.Networks[networkName]
+	if !attached || endpoint.IPAddress == "" {
+		return "", fmt.Errorf("container %s is not attached to network %s", containerID, networkName)
+	}
+
+	return endpoint.IPAddress, nil
+}
@@ -1,100 +0,0 @@
-package docker
-
-import (
-	"context"
-	"fmt"
-
-	"benchy/internal/domain/entities"
-	"benchy/internal/domain/ports"
-)
-
-// DockerClientReal - Version sans dépendances Docker pour l'instant
-type DockerClientReal struct {
-	containers map[string]bool
-}
-
-// NewDockerClientReal crée un nouveau client Docker 
-func NewDockerClientReal() (*DockerClientReal, error) {
-	return &DockerClientReal{
-		containers: make(map[string]bool),
-	}, nil
-}
-
-// CreateContainer simule la création d'un container REAL
-func (dc *DockerClientReal) CreateContainer(ctx context.Context, node *entities.Node, config ports.ContainerConfig) (string, error) {
-	containerID := fmt.Sprintf("benchy-real-%s-%s", node.Name, "abc123")
-	dc.containers[containerID] = false
-	fmt.Printf("🐳 REAL: Creating container %s with image %s\n", config.Name, config.Image)
-	return containerID, nil
-}
-
-// StartContainer simule le démarrage REAL
-func (dc *DockerClientReal) StartContainer(ctx context.Context, containerID string) error {
-	dc.containers[containerID] = true
-	fmt.Printf("🚀 REAL: Starting container %s\n", containerID[:12])
-	return nil
-}
-
-// StopContainer simule l'arrêt
-func (dc *DockerClientReal) StopContainer(ctx context.Context, containerID string) error {
-	dc.containers[containerID] = false
-	return nil
-}
-
-// IsContainerRunning vérifie si un container est en cours d'exécution
-func (dc *DockerClientReal) IsContainerRunning(ctx context.Context, containerID string) (bool, error) {
-	return dc.containers[containerID], nil
-}
-
-// GetContainerStats simule les statistiques REAL
-func (dc *DockerClientReal) GetContainerStats(ctx context.Context, containerID string) (*ports.ContainerStats, error) {
-	return &ports.ContainerStats{
-		CPUUsage:    45.5,
-		MemoryUsage: 512 * 1024 * 1024, // 512MB
-	}, nil
-}
-
-// CreateNetwork simule la création de réseau REAL
-func (dc *DockerClientReal) CreateNetwork(ctx context.Context, networkName string) error {
-	fmt.Printf("🌐 REAL: Creating Docker network %s\n", networkName)
-	return nil
-}
-
-// RemoveNetwork simule la suppression de réseau
-func (dc *DockerClientReal) RemoveNetwork(ctx context.Context, networkName string) error {
-	return nil
-}
-
-// GetContainerInfo simule la récupération d'infos REAL
-func (dc *DockerClientReal) GetContainerInfo(ctx context.Context, containerID string) (*ports.ContainerInfo, error) {
-	return &ports.ContainerInfo{
-		ID:     containerID,
-		Name:   "benchy-real",
-		Status: "running",
-	}, nil
-}
-
-// GetContainerLogs simule la récupération de logs REAL
-func (dc *DockerClientReal) GetContainerLogs(ctx context.Context, containerID string, tail int) ([]string, error) {
-	return []string{
-		"REAL: Geth started successfully",
-		"REAL: Mining enabled",
-		"REAL: RPC server listening on 8545",
-	}, nil
-}
-
-// RestartContainer simule le redémarrage
-func (dc *DockerClientReal) RestartContainer(ctx context.Context, containerID string) error {
-	return nil
-}
-
-// RemoveContainer simule la suppression
-func (dc *DockerClientReal) RemoveContainer(ctx context.Context, containerID string) error {
-	delete(dc.containers, containerID)
-	return nil
-}
-
-// ConnectToNetwork simule la connexion au réseau
-func (dc *DockerClientReal) ConnectToNetwork(ctx context.Context, containerID, networkName string) error {
-	return nil
-}
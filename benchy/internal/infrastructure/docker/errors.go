@@ -0,0 +1,72 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+)
+
+// Erreurs typées du client Docker, à tester avec errors.Is
+var (
+	ErrDaemonUnavailable   = errors.New("docker daemon unavailable")
+	ErrContainerNotFound   = errors.New("container not found")
+	ErrContainerNotRunning = errors.New("container not running")
+	ErrImageNotFound       = errors.New("image not found")
+	ErrNetworkNotFound     = errors.New("network not found")
+	ErrVolumeNotFound      = errors.New("volume not found")
+	ErrNameConflict        = errors.New("name already in use")
+	ErrPortAllocated       = errors.New("port already allocated")
+	ErrContainerFailed     = errors.New("container exited with an error")
+)
+
+// OperationError décrit l'échec d'une opération du daemon Docker. Kind est l'une des erreurs
+// typées ci-dessus (nil si l'erreur n'est pas reconnue), Err l'erreur d'origine.
+type OperationError struct {
+	Op     string // ex: "create container"
+	Target string // Container, image ou réseau visé
+	Kind   error
+	Err    error
+}
+
+func (e *OperationError) Error() string {
+	if e.Kind != nil {
+		return fmt.Sprintf("failed to %s %s: %v: %v", e.Op, e.Target, e.Kind, e.Err)
+	}
+	return fmt.Sprintf("failed to %s %s: %v", e.Op, e.Target, e.Err)
+}
+
+// Unwrap expose l'erreur d'origine (contexte annulé, erreur errdefs du daemon...)
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// Is rend l'erreur typée accessible à errors.Is
+func (e *OperationError) Is(target error) bool {
+	return e.Kind != nil && target == e.Kind
+}
+
+// wrapError classe une erreur du SDK ; notFound est l'erreur typée d'une ressource absente
+// pour cette opération (container, image, réseau ou volume)
+func wrapError(op string, target string, notFound error, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var kind error
+	switch {
+	case client.IsErrConnectionFailed(err):
+		kind = ErrDaemonUnavailable
+	case errdefs.IsNotFound(err):
+		kind = notFound
+	case errdefs.IsConflict(err):
+		kind = ErrNameConflict
+	case strings.Contains(err.Error(), "port is already allocated"), strings.Contains(err.Error(), "address already in use"):
+		// Le daemon renvoie une erreur système sans catégorie pour un port hôte déjà pris
+		kind = ErrPortAllocated
+	}
+
+	return &OperationError{Op: op, Target: target, Kind: kind, Err: err}
+}
@@ -0,0 +1,105 @@
+package docker
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// maxLogLineSize borne la taille d'une ligne de log (les traces de Nethermind peuvent être longues)
+const maxLogLineSize = 1024 * 1024
+
+// LogOptions sélectionne les logs d'un container, comme les options de docker logs
+type LogOptions struct {
+	Follow bool
+	Since  string // Durée ("10m"), date RFC 3339 ou timestamp Unix ("1697452800.5")
+	Tail   string // Nombre de lignes depuis la fin, ou "all"
+}
+
+// LogLine est une ligne de log horodatée par le daemon
+type LogLine struct {
+	Time   time.Time
+	Stderr bool
+	Text   string
+}
+
+// StreamLogs envoie les lignes de logs d'un container sur lines. Sans Follow, elle retourne à la fin
+// des logs ; avec Follow, quand le container s'arrête ou que ctx est annulé.
+func (dc *DockerClient) StreamLogs(ctx context.Context, containerID string, options LogOptions, lines chan<- LogLine) error {
+	dc.negotiateAPIVersion(ctx)
+
+	reader, err := dc.client.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Follow:     options.Follow,
+		Since:      options.Since,
+		Tail:       options.Tail,
+	})
+	if err != nil {
+		return wrapError("get logs of", containerID, ErrContainerNotFound, err)
+	}
+	defer reader.Close()
+
+	// Sans TTY, le daemon multiplexe stdout et stderr dans un même flux
+	stdout, stdoutWriter := io.Pipe()
+	stderr, stderrWriter := io.Pipe()
+	go func() {
+		_, err := stdcopy.StdCopy(stdoutWriter, stderrWriter, reader)
+		stdoutWriter.CloseWithError(err)
+		stderrWriter.CloseWithError(err)
+	}()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, source := range []io.Reader{stdout, stderr} {
+		wg.Add(1)
+		go func(i int, source io.Reader) {
+			defer wg.Done()
+			errs[i] = scanLogLines(ctx, source, i == 1, lines)
+		}(i, source)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, err := range errs {
+		if err != nil {
+			return wrapError("read logs of", containerID, ErrContainerNotFound, err)
+		}
+	}
+	return nil
+}
+
+// scanLogLines découpe un flux en lignes et sépare l'horodatage ajouté par le daemon
+func scanLogLines(ctx context.Context, source io.Reader, stderr bool, lines chan<- LogLine) error {
+	scanner := bufio.NewScanner(source)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+
+	for scanner.Scan() {
+		line := LogLine{Stderr: stderr, Text: scanner.Text()}
+		if timestamp, text, found := strings.Cut(line.Text, " "); found {
+			if parsed, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+				line.Time = parsed
+				line.Text = text
+			}
+		}
+
+		select {
+		case lines <- line:
+		case <-ctx.Done():
+			// Vider le flux pour ne pas bloquer le démultiplexage
+			io.Copy(io.Discard, source)
+			return ctx.Err()
+		}
+	}
+
+	return scanner.Err()
+}
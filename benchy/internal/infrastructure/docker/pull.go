@@ -0,0 +1,127 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/docker/go-units"
+)
+
+// ensureImage télécharge l'image si elle n'est pas déjà présente localement
+func (dc *DockerClient) ensureImage(ctx context.Context, image string) error {
+	_, _, err := dc.client.ImageInspectWithRaw(ctx, image)
+	if err == nil {
+		return nil
+	}
+	if !errdefs.IsNotFound(err) {
+		return wrapError("inspect image", image, ErrImageNotFound, err)
+	}
+
+	return dc.PullImage(ctx, image)
+}
+
+// PullImage télécharge une image et affiche la progression des couches dans le feedback
+func (dc *DockerClient) PullImage(ctx context.Context, image string) error {
+	reader, err := dc.client.ImagePull(ctx, image, types.ImagePullOptions{})
+	if err != nil {
+		return wrapError("pull image", image, ErrImageNotFound, err)
+	}
+	defer reader.Close()
+
+	spinner, err := dc.feedback.StartSpinner(ctx, fmt.Sprintf("Pulling %s...", image))
+	if err != nil {
+		return err
+	}
+
+	progress := newPullProgress()
+	decoder := json.NewDecoder(reader)
+	for {
+		var message jsonmessage.JSONMessage
+		if err := decoder.Decode(&message); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			spinner.Error(fmt.Sprintf("❌ Pull of %s interrupted", image))
+			return wrapError("pull image", image, ErrImageNotFound, err)
+		}
+
+		// Les erreurs du registre (manifest inconnu, authentification...) arrivent dans le flux
+		if message.Error != nil {
+			spinner.Error(fmt.Sprintf("❌ Failed to pull %s", image))
+			return wrapError("pull image", image, ErrImageNotFound, message.Error)
+		}
+
+		if progress.update(message) {
+			spinner.UpdateMessage(fmt.Sprintf("Pulling %s: %s", image, progress))
+		}
+	}
+
+	spinner.Success(fmt.Sprintf("✅ Pulled %s", image))
+	return nil
+}
+
+// pullProgress agrège la progression des couches d'un pull
+type pullProgress struct {
+	layers     []string          // Ordre d'apparition des couches
+	downloaded map[string]int64  // Octets téléchargés par couche
+	sizes      map[string]int64  // Taille de chaque couche
+	done       map[string]bool   // Couches extraites ou déjà présentes
+	status     map[string]string // Dernier statut de chaque couche
+}
+
+func newPullProgress() *pullProgress {
+	return &pullProgress{
+		downloaded: make(map[string]int64),
+		sizes:      make(map[string]int64),
+		done:       make(map[string]bool),
+		status:     make(map[string]string),
+	}
+}
+
+// update intègre un message du flux ; retourne false pour les messages sans couche (tag, digest, statut final)
+func (p *pullProgress) update(message jsonmessage.JSONMessage) bool {
+	if message.ID == "" || message.Status == "" || strings.HasPrefix(message.Status, "Pulling from") {
+		return false
+	}
+
+	if _, known := p.status[message.ID]; !known {
+		p.layers = append(p.layers, message.ID)
+	}
+	p.status[message.ID] = message.Status
+
+	switch message.Status {
+	case "Downloading":
+		if message.Progress != nil {
+			p.downloaded[message.ID] = message.Progress.Current
+			p.sizes[message.ID] = message.Progress.Total
+		}
+	case "Download complete":
+		p.downloaded[message.ID] = p.sizes[message.ID]
+	case "Pull complete", "Already exists":
+		p.done[message.ID] = true
+	}
+
+	return true
+}
+
+// String résume la progression : couches terminées et volume téléchargé
+func (p *pullProgress) String() string {
+	var downloaded, total int64
+	for _, layer := range p.layers {
+		downloaded += p.downloaded[layer]
+		total += p.sizes[layer]
+	}
+
+	summary := fmt.Sprintf("%d/%d layers", len(p.done), len(p.layers))
+	if total > 0 {
+		summary += fmt.Sprintf(", %s/%s", units.HumanSize(float64(downloaded)), units.HumanSize(float64(total)))
+	}
+	return summary
+}
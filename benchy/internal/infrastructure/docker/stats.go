@@ -0,0 +1,155 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"benchy/internal/domain/ports"
+	"github.com/docker/docker/api/types"
+)
+
+// StatsCollector garde un flux de statistiques ouvert par container (une mesure par seconde côté daemon)
+// et en conserve la dernière valeur : la lecture du cache ne fait aucun appel au daemon.
+type StatsCollector struct {
+	client *DockerClient
+	ctx    context.Context // Annulé par Close : les flux survivent aux commandes qui les ont ouverts
+	cancel context.CancelFunc
+
+	mu      sync.RWMutex
+	latest  map[string]*ports.ContainerStats // Dernière mesure complète par container
+	streams map[string]*statsStream          // Flux ouverts par container
+}
+
+// statsStream est le flux de statistiques d'un container
+type statsStream struct {
+	cancel context.CancelFunc
+	ready  chan struct{} // Fermé à la première mesure complète, ou à la fin du flux
+}
+
+// NewStatsCollector crée un collecteur de statistiques sans flux ouvert
+func NewStatsCollector(client *DockerClient) *StatsCollector {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &StatsCollector{
+		client:  client,
+		ctx:     ctx,
+		cancel:  cancel,
+		latest:  make(map[string]*ports.ContainerStats),
+		streams: make(map[string]*statsStream),
+	}
+}
+
+// Watch ouvre le flux de statistiques d'un container s'il ne l'est pas déjà. Le flux se ferme
+// à l'appel de Close ou quand le container s'arrête : un nouvel appel le rouvre.
+func (sc *StatsCollector) Watch(containerID string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if sc.ctx.Err() != nil {
+		return
+	}
+	if _, watching := sc.streams[containerID]; watching {
+		return
+	}
+
+	streamCtx, cancel := context.WithCancel(sc.ctx)
+	stream := &statsStream{cancel: cancel, ready: make(chan struct{})}
+	sc.streams[containerID] = stream
+
+	go sc.collect(streamCtx, containerID, stream)
+}
+
+// Stats retourne la dernière mesure d'un container sans attendre ; false si aucune mesure n'est disponible
+func (sc *StatsCollector) Stats(containerID string) (*ports.ContainerStats, bool) {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	stats, ok := sc.latest[containerID]
+	if !ok {
+		return nil, false
+	}
+	snapshot := *stats
+	return &snapshot, true
+}
+
+// WaitStats attend la première mesure d'un container surveillé (deux échantillons sont nécessaires
+// au calcul du CPU), ou l'annulation de ctx
+func (sc *StatsCollector) WaitStats(ctx context.Context, containerID string) (*ports.ContainerStats, bool) {
+	sc.mu.RLock()
+	stream, watching := sc.streams[containerID]
+	sc.mu.RUnlock()
+
+	if watching {
+		select {
+		case <-stream.ready:
+		case <-ctx.Done():
+		}
+	}
+
+	return sc.Stats(containerID)
+}
+
+// Close ferme tous les flux ouverts
+func (sc *StatsCollector) Close() {
+	sc.cancel()
+}
+
+// collect lit le flux d'un container jusqu'à sa fin et met à jour le cache
+func (sc *StatsCollector) collect(ctx context.Context, containerID string, stream *statsStream) {
+	defer sc.forget(containerID, stream)
+	sc.client.negotiateAPIVersion(ctx)
+
+	response, err := sc.client.client.ContainerStats(ctx, containerID, true)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+
+	decoder := json.NewDecoder(response.Body)
+	var previous *types.CPUStats
+	for {
+		var sample types.StatsJSON
+		if err := decoder.Decode(&sample); err != nil {
+			return
+		}
+
+		// Un container arrêté renvoie des mesures vides : le flux n'a plus rien à apporter
+		if sample.Read.IsZero() {
+			return
+		}
+
+		// Le CPU se calcule entre deux échantillons consécutifs du flux
+		if previous != nil {
+			stats := containerStats(&sample, *previous)
+
+			sc.mu.Lock()
+			sc.latest[containerID] = stats
+			sc.mu.Unlock()
+
+			markReady(stream)
+		}
+		previous = &sample.CPUStats
+	}
+}
+
+// forget retire le flux terminé et sa dernière mesure, devenue obsolète
+func (sc *StatsCollector) forget(containerID string, stream *statsStream) {
+	stream.cancel()
+	markReady(stream)
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.streams[containerID] == stream {
+		delete(sc.streams, containerID)
+		delete(sc.latest, containerID)
+	}
+}
+
+// markReady débloque les appels à WaitStats, une seule fois
+func markReady(stream *statsStream) {
+	select {
+	case <-stream.ready:
+	default:
+		close(stream.ready)
+	}
+}
@@ -0,0 +1,58 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/errdefs"
+)
+
+// CreateVolume crée un volume Docker nommé avec ses labels, s'il n'existe pas déjà. Un volume existant
+// sans ces labels est refusé : ses données ne sont pas celles de benchy.
+func (dc *DockerClient) CreateVolume(ctx context.Context, volumeName string, labels map[string]string) error {
+	existing, err := dc.client.VolumeInspect(ctx, volumeName)
+	if err == nil {
+		if hasLabels(existing.Labels, labels) {
+			return nil
+		}
+		return &OperationError{
+			Op:     "create volume",
+			Target: volumeName,
+			Kind:   ErrNameConflict,
+			Err:    errors.New("an unlabelled volume already uses this name"),
+		}
+	}
+	if !errdefs.IsNotFound(err) {
+		return wrapError("inspect volume", volumeName, ErrVolumeNotFound, err)
+	}
+
+	if _, err := dc.client.VolumeCreate(ctx, volume.CreateOptions{Name: volumeName, Labels: labels}); err != nil {
+		return wrapError("create volume", volumeName, ErrVolumeNotFound, err)
+	}
+	return nil
+}
+
+// RemoveVolume supprime un volume Docker ; il ne doit plus être utilisé par un container
+func (dc *DockerClient) RemoveVolume(ctx context.Context, volumeName string) error {
+	if err := dc.client.VolumeRemove(ctx, volumeName, false); err != nil {
+		return wrapError("remove volume", volumeName, ErrVolumeNotFound, err)
+	}
+	return nil
+}
+
+// ListVolumes retourne le nom des volumes qui portent tous les labels donnés
+func (dc *DockerClient) ListVolumes(ctx context.Context, labels map[string]string) ([]string, error) {
+	response, err := dc.client.VolumeList(ctx, volume.ListOptions{Filters: labelFilters(labels)})
+	if err != nil {
+		return nil, wrapError("list volumes", describeLabels(labels), ErrVolumeNotFound, err)
+	}
+
+	names := make([]string, 0, len(response.Volumes))
+	for _, resource := range response.Volumes {
+		names = append(names, resource.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
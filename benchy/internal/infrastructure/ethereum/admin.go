@@ -0,0 +1,88 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"benchy/internal/domain/ports"
+)
+
+// nodeInfoJSON est la réponse de admin_nodeInfo (champs communs à Geth et Nethermind)
+type nodeInfoJSON struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Enode      string `json:"enode"`
+	IP         string `json:"ip"`
+	ListenAddr string `json:"listenAddr"`
+}
+
+// GetNodeInfo retourne l'identité P2P du node (admin_nodeInfo, module admin requis)
+func (ec *EthereumClient) GetNodeInfo(ctx context.Context, nodeURL string) (*ports.NodeP2PInfo, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw nodeInfoJSON
+	if err := rpcClient.CallContext(ctx, &raw, "admin_nodeInfo"); err != nil {
+		return nil, fmt.Errorf("failed to get node info: %w", err)
+	}
+	if raw.Enode == "" {
+		return nil, fmt.Errorf("node info has no enode")
+	}
+
+	return &ports.NodeP2PInfo{
+		ID:         raw.ID,
+		Name:       raw.Name,
+		Enode:      raw.Enode,
+		IP:         raw.IP,
+		ListenAddr: raw.ListenAddr,
+	}, nil
+}
+
+// AddPeer demande au node de se connecter à un enode et de le garder comme peer statique (admin_addPeer)
+func (ec *EthereumClient) AddPeer(ctx context.Context, nodeURL string, enode string) error {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return err
+	}
+
+	var added bool
+	if err := rpcClient.CallContext(ctx, &added, "admin_addPeer", enode); err != nil {
+		return fmt.Errorf("failed to add peer: %w", err)
+	}
+	if !added {
+		return fmt.Errorf("node refused peer %s", enode)
+	}
+
+	return nil
+}
+
+// EnodeWithHost remplace l'adresse IP d'un enode, par exemple par l'IP du container sur le réseau
+// Docker : les nodes annoncent 127.0.0.1 ou leur IP publique, injoignables depuis les autres containers
+func EnodeWithHost(enode string, host string) (string, error) {
+	parsed, err := url.Parse(enode)
+	if err != nil || parsed.Scheme != "enode" || parsed.User == nil {
+		return "", fmt.Errorf("invalid enode: %s", enode)
+	}
+
+	// Clé publique secp256k1 non compressée, sans le préfixe 04
+	if id, err := hex.DecodeString(parsed.User.Username()); err != nil || len(id) != 64 {
+		return "", fmt.Errorf("invalid enode id: %s", enode)
+	}
+
+	port := parsed.Port()
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", fmt.Errorf("invalid enode port: %s", enode)
+	}
+	if net.ParseIP(host) == nil {
+		return "", fmt.Errorf("invalid IP address: %s", host)
+	}
+
+	parsed.Host = net.JoinHostPort(host, port)
+	return parsed.String(), nil
+}
@@ -0,0 +1,100 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// GetNodeSnapshot récupère bloc, peers, mempool et balances dans une seule requête HTTP batch
+func (ec *EthereumClient) GetNodeSnapshot(ctx context.Context, nodeURL string, address common.Address, tokens map[string]common.Address) (*ports.NodeSnapshot, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		blockNumber hexutil.Uint64
+		peerCount   hexutil.Uint64
+		pendingTxs  hexutil.Uint
+		balance     hexutil.Big
+	)
+
+	batch := []rpc.BatchElem{
+		{Method: "eth_blockNumber", Result: &blockNumber},
+		{Method: "net_peerCount", Result: &peerCount},
+		{Method: "eth_getBlockTransactionCountByNumber", Args: []interface{}{"pending"}, Result: &pendingTxs},
+	}
+
+	hasAddress := address != (common.Address{})
+	if hasAddress {
+		batch = append(batch, rpc.BatchElem{Method: "eth_getBalance", Args: []interface{}{address, "latest"}, Result: &balance})
+	}
+
+	// Un eth_call balanceOf par token, ajouté au même batch
+	var symbols []string
+	var tokenResults []hexutil.Bytes
+	if hasAddress {
+		data, err := contracts.PackBalanceOf(address)
+		if err != nil {
+			return nil, err
+		}
+
+		for symbol := range tokens {
+			symbols = append(symbols, symbol)
+		}
+		tokenResults = make([]hexutil.Bytes, len(symbols))
+		for i, symbol := range symbols {
+			call := map[string]interface{}{
+				"to":   tokens[symbol],
+				"data": hexutil.Bytes(data),
+			}
+			batch = append(batch, rpc.BatchElem{Method: "eth_call", Args: []interface{}{call, "latest"}, Result: &tokenResults[i]})
+		}
+	}
+
+	if err := rpcClient.BatchCallContext(ctx, batch); err != nil {
+		return nil, fmt.Errorf("failed to query node: %w", err)
+	}
+
+	// Sans numéro de bloc le node n'est pas exploitable
+	if batch[0].Error != nil {
+		return nil, fmt.Errorf("failed to get block number: %w", batch[0].Error)
+	}
+
+	snapshot := &ports.NodeSnapshot{
+		LatestBlock:   uint64(blockNumber),
+		TokenBalances: make(map[string]*big.Int),
+	}
+	if batch[1].Error == nil {
+		peers := int(peerCount)
+		snapshot.PeerCount = &peers
+	}
+	if batch[2].Error == nil {
+		pending := int(pendingTxs)
+		snapshot.PendingTxs = &pending
+	}
+
+	if hasAddress {
+		if batch[3].Error == nil {
+			snapshot.Balance = balance.ToInt()
+		}
+
+		for i, symbol := range symbols {
+			if batch[4+i].Error != nil {
+				continue
+			}
+			if tokenBalance, err := contracts.UnpackUint256("balanceOf", tokenResults[i]); err == nil {
+				snapshot.TokenBalances[symbol] = tokenBalance
+			}
+		}
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,569 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// EthereumClient implémente l'interface EthereumService via JSON-RPC
+type EthereumClient struct {
+	clients    map[string]*ethclient.Client
+	rpcClients map[string]*rpc.Client
+	chainIDs   map[string]*big.Int
+	keys       map[common.Address]*ecdsa.PrivateKey
+	nonces     *NonceManager
+	events     *EventDecoder
+	feePolicy  entities.FeePolicy
+	mutex      sync.RWMutex
+}
+
+var _ ports.EthereumService = (*EthereumClient)(nil)
+
+// NewEthereumClient crée un nouveau client Ethereum
+func NewEthereumClient() *EthereumClient {
+	return &EthereumClient{
+		clients:    make(map[string]*ethclient.Client),
+		rpcClients: make(map[string]*rpc.Client),
+		chainIDs:   make(map[string]*big.Int),
+		keys:       make(map[common.Address]*ecdsa.PrivateKey),
+		nonces:     NewNonceManager(),
+		events:     NewEventDecoder(),
+		feePolicy:  entities.FeePolicyNormal,
+	}
+}
+
+// ConnectToNode ouvre (ou réutilise) la connexion vers un node
+func (ec *EthereumClient) ConnectToNode(ctx context.Context, nodeURL string) error {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return err
+	}
+
+	// Tester la connexion
+	if _, err := client.NetworkID(ctx); err != nil {
+		ec.DisconnectFromNode(ctx, nodeURL)
+		return fmt.Errorf("failed to get network ID: %w", err)
+	}
+
+	return nil
+}
+
+// DisconnectFromNode ferme la connexion vers un node
+func (ec *EthereumClient) DisconnectFromNode(ctx context.Context, nodeURL string) error {
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+
+	if rpcClient, exists := ec.rpcClients[nodeURL]; exists {
+		rpcClient.Close()
+		delete(ec.clients, nodeURL)
+		delete(ec.rpcClients, nodeURL)
+	}
+
+	return nil
+}
+
+// IsNodeConnected vérifie si un node est connecté et répond
+func (ec *EthereumClient) IsNodeConnected(ctx context.Context, nodeURL string) (bool, error) {
+	ec.mutex.RLock()
+	client, exists := ec.clients[nodeURL]
+	ec.mutex.RUnlock()
+
+	if !exists {
+		return false, nil
+	}
+
+	_, err := client.NetworkID(ctx)
+	return err == nil, nil
+}
+
+// Close ferme toutes les connexions ouvertes
+func (ec *EthereumClient) Close() {
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+
+	for nodeURL, rpcClient := range ec.rpcClients {
+		rpcClient.Close()
+		delete(ec.clients, nodeURL)
+		delete(ec.rpcClients, nodeURL)
+	}
+}
+
+// GetLatestBlockNumber récupère le numéro du dernier bloc
+func (ec *EthereumClient) GetLatestBlockNumber(ctx context.Context, nodeURL string) (uint64, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return 0, err
+	}
+
+	blockNumber, err := client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+
+	return blockNumber, nil
+}
+
+// GetBlockByNumber récupère un bloc par son numéro
+func (ec *EthereumClient) GetBlockByNumber(ctx context.Context, nodeURL string, blockNumber uint64) (*ports.BlockInfo, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := client.BlockByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", blockNumber, err)
+	}
+
+	chainID, err := ec.getChainID(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.LatestSignerForChainID(chainID)
+
+	// Extraire les transactions et leur expéditeur
+	txHashes := make([]common.Hash, len(block.Transactions()))
+	txDetails := make([]ports.TransactionInfo, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recover sender of %s: %w", tx.Hash().Hex(), err)
+		}
+
+		number := block.NumberU64()
+		txHashes[i] = tx.Hash()
+		txDetails[i] = toTransactionInfo(tx, from)
+		txDetails[i].BlockNumber = &number
+		txDetails[i].BlockHash = block.Hash()
+		txDetails[i].TransactionIndex = uint(i)
+	}
+
+	info := &ports.BlockInfo{
+		Number:       block.NumberU64(),
+		Hash:         block.Hash(),
+		ParentHash:   block.ParentHash(),
+		Timestamp:    block.Time(),
+		Difficulty:   block.Difficulty(),
+		GasLimit:     block.GasLimit(),
+		GasUsed:      block.GasUsed(),
+		Transactions: txHashes,
+		Miner:        block.Coinbase(),
+		BaseFee:      block.BaseFee(),
+		ExtraData:    block.Extra(),
+		TxDetails:    txDetails,
+	}
+
+	// Le bloc genesis n'est pas scellé
+	if block.NumberU64() > 0 {
+		if info.Signer, err = cliqueSigner(block.Header()); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// GetHeaderByNumber récupère l'en-tête d'un bloc, sans ses transactions ; nil : le dernier bloc
+func (ec *EthereumClient) GetHeaderByNumber(ctx context.Context, nodeURL string, blockNumber *uint64) (*ports.BlockHeader, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var number *big.Int
+	if blockNumber != nil {
+		number = new(big.Int).SetUint64(*blockNumber)
+	}
+
+	header, err := client.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block header: %w", err)
+	}
+
+	return toBlockHeader(header), nil
+}
+
+// GetTransaction récupère une transaction, en attente ou minée, par son hash
+func (ec *EthereumClient) GetTransaction(ctx context.Context, nodeURL string, txHash common.Hash) (*ports.TransactionInfo, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// types.Transaction ne contient ni l'expéditeur ni la position dans la chaîne : on décode la réponse brute deux fois
+	var raw json.RawMessage
+	if err := rpcClient.CallContext(ctx, &raw, "eth_getTransactionByHash", txHash); err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, ethereum.NotFound
+	}
+
+	var tx types.Transaction
+	if err := json.Unmarshal(raw, &tx); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+
+	var position struct {
+		From             common.Address  `json:"from"`
+		BlockNumber      *hexutil.Big    `json:"blockNumber"`
+		BlockHash        *common.Hash    `json:"blockHash"`
+		TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+	}
+	if err := json.Unmarshal(raw, &position); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+
+	info := toTransactionInfo(&tx, position.From)
+	if position.BlockNumber != nil {
+		number := position.BlockNumber.ToInt().Uint64()
+		info.BlockNumber = &number
+	}
+	if position.BlockHash != nil {
+		info.BlockHash = *position.BlockHash
+	}
+	if position.TransactionIndex != nil {
+		info.TransactionIndex = uint(*position.TransactionIndex)
+	}
+
+	return &info, nil
+}
+
+// GetPeerCount récupère le nombre de peers connectés
+func (ec *EthereumClient) GetPeerCount(ctx context.Context, nodeURL string) (int, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return 0, err
+	}
+
+	var result hexutil.Uint64
+	if err := rpcClient.CallContext(ctx, &result, "net_peerCount"); err != nil {
+		return 0, fmt.Errorf("failed to get peer count: %w", err)
+	}
+
+	return int(result), nil
+}
+
+// GetPendingTransactionCount récupère le nombre de transactions en attente
+func (ec *EthereumClient) GetPendingTransactionCount(ctx context.Context, nodeURL string) (int, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := client.PendingTransactionCount(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending transaction count: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// GetBalance récupère la balance d'une adresse
+func (ec *EthereumClient) GetBalance(ctx context.Context, nodeURL string, address common.Address) (*big.Int, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := client.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+// GetNonce récupère le nonce (pending) d'une adresse
+func (ec *EthereumClient) GetNonce(ctx context.Context, nodeURL string, address common.Address) (uint64, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, err := client.PendingNonceAt(ctx, address)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	return nonce, nil
+}
+
+// GetMinedNonce récupère le nonce d'une adresse au dernier bloc, sans ses transactions en attente
+func (ec *EthereumClient) GetMinedNonce(ctx context.Context, nodeURL string, address common.Address) (uint64, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, err := client.NonceAt(ctx, address, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get mined nonce: %w", err)
+	}
+
+	return nonce, nil
+}
+
+// GetCode récupère le bytecode déployé à une adresse (vide pour un compte externe)
+func (ec *EthereumClient) GetCode(ctx context.Context, nodeURL string, address common.Address) ([]byte, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code: %w", err)
+	}
+
+	return code, nil
+}
+
+// SendTransaction signe localement la transaction avec la clé de l'expéditeur et la diffuse
+func (ec *EthereumClient) SendTransaction(ctx context.Context, nodeURL string, tx *entities.Transaction) (common.Hash, error) {
+	to := tx.To
+	return ec.signAndSend(ctx, nodeURL, tx, &to)
+}
+
+// GetTransactionStatus récupère le statut d'une transaction
+func (ec *EthereumClient) GetTransactionStatus(ctx context.Context, nodeURL string, txHash common.Hash) (entities.TransactionStatus, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return entities.TxStatusPending, err
+	}
+
+	_, isPending, err := client.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return entities.TxStatusPending, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if isPending {
+		return entities.TxStatusPending, nil
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return entities.TxStatusPending, nil
+		}
+		return entities.TxStatusPending, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return entities.TxStatusConfirmed, nil
+	}
+
+	return entities.TxStatusFailed, nil
+}
+
+// GetTransactionReceipt récupère le reçu d'une transaction
+func (ec *EthereumClient) GetTransactionReceipt(ctx context.Context, nodeURL string, txHash common.Hash) (*ports.TransactionReceipt, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// types.Receipt ne contient pas from/to : on décode la réponse brute deux fois
+	var raw json.RawMessage
+	if err := rpcClient.CallContext(ctx, &raw, "eth_getTransactionReceipt", txHash); err != nil {
+		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, ethereum.NotFound
+	}
+
+	var receipt types.Receipt
+	if err := json.Unmarshal(raw, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction receipt: %w", err)
+	}
+
+	var parties struct {
+		From common.Address  `json:"from"`
+		To   *common.Address `json:"to"`
+	}
+	if err := json.Unmarshal(raw, &parties); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction receipt: %w", err)
+	}
+
+	result := toPortReceipt(&receipt, parties.From, parties.To)
+	ec.events.DecodeReceipt(result)
+
+	return result, nil
+}
+
+// DeployContract déploie un smart contract signé localement par from
+func (ec *EthereumClient) DeployContract(ctx context.Context, nodeURL string, contractCode []byte, from common.Address) (common.Address, common.Hash, error) {
+	tx := entities.NewTransaction(from, common.Address{}, big.NewInt(0), entities.TxTypeContract)
+	tx.Data = contractCode
+
+	hash, err := ec.signAndSend(ctx, nodeURL, tx, nil)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to deploy contract: %w", err)
+	}
+
+	return crypto.CreateAddress(from, tx.Nonce), hash, nil
+}
+
+// CallContract appelle une méthode en lecture seule d'un smart contract
+func (ec *EthereumClient) CallContract(ctx context.Context, nodeURL string, contractAddress common.Address, data []byte) ([]byte, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := ethereum.CallMsg{
+		To:   &contractAddress,
+		Data: data,
+	}
+
+	result, err := client.CallContract(ctx, msg, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetTokenBalance récupère la balance d'un token ERC20 (balanceOf)
+func (ec *EthereumClient) GetTokenBalance(ctx context.Context, nodeURL string, tokenAddress, holderAddress common.Address) (*big.Int, error) {
+	data, err := contracts.PackBalanceOf(holderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ec.CallContract(ctx, nodeURL, tokenAddress, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token balance: %w", err)
+	}
+
+	return contracts.UnpackUint256("balanceOf", result)
+}
+
+// TransferToken transfère des tokens ERC20 (transfer)
+func (ec *EthereumClient) TransferToken(ctx context.Context, nodeURL string, tokenAddress, from, to common.Address, amount *big.Int) (common.Hash, error) {
+	data, err := contracts.PackTransfer(to, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx := entities.NewTransaction(from, tokenAddress, big.NewInt(0), entities.TxTypeERC20)
+	tx.Data = data
+
+	hash, err := ec.signAndSend(ctx, nodeURL, tx, &tokenAddress)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to transfer token: %w", err)
+	}
+
+	return hash, nil
+}
+
+// Méthodes utilitaires privées
+
+// getClient retourne le client ethclient du node, en ouvrant la connexion si besoin
+func (ec *EthereumClient) getClient(ctx context.Context, nodeURL string) (*ethclient.Client, error) {
+	if _, err := ec.getRPCClient(ctx, nodeURL); err != nil {
+		return nil, err
+	}
+
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	return ec.clients[nodeURL], nil
+}
+
+// getRPCClient retourne la connexion RPC du node, en l'ouvrant si besoin
+func (ec *EthereumClient) getRPCClient(ctx context.Context, nodeURL string) (*rpc.Client, error) {
+	ec.mutex.RLock()
+	rpcClient, exists := ec.rpcClients[nodeURL]
+	ec.mutex.RUnlock()
+	if exists {
+		return rpcClient, nil
+	}
+
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+
+	// Une autre goroutine a pu ouvrir la connexion entre-temps
+	if rpcClient, exists := ec.rpcClients[nodeURL]; exists {
+		return rpcClient, nil
+	}
+
+	rpcClient, err := rpc.DialContext(ctx, nodeURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", nodeURL, err)
+	}
+
+	ec.rpcClients[nodeURL] = rpcClient
+	ec.clients[nodeURL] = ethclient.NewClient(rpcClient)
+
+	return rpcClient, nil
+}
+
+// toPortReceipt convertit un reçu go-ethereum vers le type du domaine
+func toPortReceipt(receipt *types.Receipt, from common.Address, to *common.Address) *ports.TransactionReceipt {
+	logs := make([]ports.LogEntry, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = ports.LogEntry{
+			Address: log.Address,
+			Topics:  log.Topics,
+			Data:    log.Data,
+		}
+	}
+
+	result := &ports.TransactionReceipt{
+		TransactionHash:  receipt.TxHash,
+		BlockHash:        receipt.BlockHash,
+		TransactionIndex: receipt.TransactionIndex,
+		From:             from,
+		GasUsed:          receipt.GasUsed,
+		Status:           receipt.Status,
+		ContractAddress:  receipt.ContractAddress,
+		Logs:             logs,
+	}
+	if receipt.BlockNumber != nil {
+		result.BlockNumber = receipt.BlockNumber.Uint64()
+	}
+	if to != nil {
+		result.To = *to
+	}
+
+	return result
+}
+
+// toTransactionInfo convertit une transaction go-ethereum vers le type du domaine
+func toTransactionInfo(tx *types.Transaction, from common.Address) ports.TransactionInfo {
+	info := ports.TransactionInfo{
+		Hash:  tx.Hash(),
+		From:  from,
+		To:    tx.To(),
+		Nonce: tx.Nonce(),
+		Value: tx.Value(),
+		Gas:   tx.Gas(),
+		Type:  tx.Type(),
+		Input: tx.Data(),
+	}
+
+	if tx.Type() == types.DynamicFeeTxType {
+		info.GasTipCap = tx.GasTipCap()
+		info.GasFeeCap = tx.GasFeeCap()
+	} else {
+		info.GasPrice = tx.GasPrice()
+	}
+
+	return info
+}
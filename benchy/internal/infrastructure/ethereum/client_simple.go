@@ -1,99 +0,0 @@
-package ethereum
-
-import (
-	"context"
-	"fmt"
-	"math/big"
-
-	"benchy/internal/domain/entities"
-	"benchy/internal/domain/ports"
-)
-
-// EthereumClient version simplifiée sans go-ethereum
-type EthereumClient struct {
-	connections map[string]bool
-}
-
-// NewEthereumClient crée un nouveau client simplifié
-func NewEthereumClient() *EthereumClient {
-	return &EthereumClient{
-		connections: make(map[string]bool),
-	}
-}
-
-// ConnectToNode simule une connexion
-func (ec *EthereumClient) ConnectToNode(ctx context.Context, nodeURL string) error {
-	ec.connections[nodeURL] = true
-	return nil
-}
-
-// DisconnectFromNode simule une déconnexion
-func (ec *EthereumClient) DisconnectFromNode(ctx context.Context, nodeURL string) error {
-	delete(ec.connections, nodeURL)
-	return nil
-}
-
-// IsNodeConnected vérifie la connexion simulée
-func (ec *EthereumClient) IsNodeConnected(ctx context.Context, nodeURL string) (bool, error) {
-	return ec.connections[nodeURL], nil
-}
-
-// GetLatestBlockNumber retourne un numéro de bloc simulé
-func (ec *EthereumClient) GetLatestBlockNumber(ctx context.Context, nodeURL string) (uint64, error) {
-	return 1234, nil
-}
-
-// GetPeerCount retourne un nombre de peers simulé
-func (ec *EthereumClient) GetPeerCount(ctx context.Context, nodeURL string) (int, error) {
-	return 4, nil
-}
-
-// GetPendingTransactionCount retourne un nombre de transactions simulé
-func (ec *EthereumClient) GetPendingTransactionCount(ctx context.Context, nodeURL string) (int, error) {
-	return 2, nil
-}
-
-// GetBalance retourne une balance simulée
-func (ec *EthereumClient) GetBalance(ctx context.Context, nodeURL string, address interface{}) (*big.Int, error) {
-	// Balance simulée entre 0 et 100 ETH
-	balance := big.NewInt(int64(50 + len(nodeURL)%50))
-	balance.Mul(balance, big.NewInt(1e18)) // Convertir en wei
-	return balance, nil
-}
-
-// Méthodes non implémentées pour l'instant
-func (ec *EthereumClient) GetBlockByNumber(ctx context.Context, nodeURL string, blockNumber uint64) (*ports.BlockInfo, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) GetNonce(ctx context.Context, nodeURL string, address interface{}) (uint64, error) {
-	return 0, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) SendTransaction(ctx context.Context, nodeURL string, tx *entities.Transaction) (interface{}, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) GetTransactionStatus(ctx context.Context, nodeURL string, txHash interface{}) (entities.TransactionStatus, error) {
-	return entities.TxStatusPending, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) GetTransactionReceipt(ctx context.Context, nodeURL string, txHash interface{}) (*ports.TransactionReceipt, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) DeployContract(ctx context.Context, nodeURL string, contractCode []byte, from interface{}) (interface{}, interface{}, error) {
-	return nil, nil, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) CallContract(ctx context.Context, nodeURL string, contractAddress interface{}, data []byte) ([]byte, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) GetTokenBalance(ctx context.Context, nodeURL string, tokenAddress, holderAddress interface{}) (*big.Int, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (ec *EthereumClient) TransferToken(ctx context.Context, nodeURL string, tokenAddress, from, to interface{}, amount *big.Int) (interface{}, error) {
-	return nil, fmt.Errorf("not implemented")
-}
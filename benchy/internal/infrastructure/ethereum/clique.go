@@ -0,0 +1,208 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"benchy/internal/domain/ports"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// cliqueExtraSeal est la taille de la signature du signer à la fin de extraData
+const cliqueExtraSeal = crypto.SignatureLength
+
+// cliqueSnapshotJSON est la réponse de clique_getSnapshot (Geth et Nethermind)
+type cliqueSnapshotJSON struct {
+	Number  hexOrNumber                        `json:"number"`
+	Hash    common.Hash                        `json:"hash"`
+	Signers map[common.Address]json.RawMessage `json:"signers"` // {} chez Geth, bloc d'ajout chez Nethermind
+	Recents map[string]common.Address          `json:"recents"`
+	Votes   []struct {
+		Signer    common.Address `json:"signer"`
+		Block     hexOrNumber    `json:"block"`
+		Address   common.Address `json:"address"`
+		Authorize bool           `json:"authorize"`
+	} `json:"votes"`
+	Tally map[common.Address]struct {
+		Authorize bool `json:"authorize"`
+		Votes     int  `json:"votes"`
+	} `json:"tally"`
+}
+
+// hexOrNumber accepte un numéro de bloc JSON en décimal (Geth) ou en hexadécimal (Nethermind)
+type hexOrNumber uint64
+
+// UnmarshalJSON décode 42, "42" ou "0x2a"
+func (n *hexOrNumber) UnmarshalJSON(data []byte) error {
+	text := string(bytes.Trim(data, `"`))
+
+	if value, err := hexutil.DecodeUint64(text); err == nil {
+		*n = hexOrNumber(value)
+		return nil
+	}
+
+	value, err := strconv.ParseUint(text, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid block number %s", data)
+	}
+	*n = hexOrNumber(value)
+
+	return nil
+}
+
+// GetSigners retourne les validateurs Clique autorisés au dernier bloc (clique_getSigners)
+func (ec *EthereumClient) GetSigners(ctx context.Context, nodeURL string) ([]common.Address, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var signers []common.Address
+	if err := rpcClient.CallContext(ctx, &signers, "clique_getSigners"); err != nil {
+		return nil, fmt.Errorf("failed to get clique signers: %w", err)
+	}
+
+	sortAddresses(signers)
+	return signers, nil
+}
+
+// GetSnapshot retourne l'état Clique au dernier bloc : signers, votes et décomptes (clique_getSnapshot)
+func (ec *EthereumClient) GetSnapshot(ctx context.Context, nodeURL string) (*ports.CliqueSnapshot, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw cliqueSnapshotJSON
+	if err := rpcClient.CallContext(ctx, &raw, "clique_getSnapshot"); err != nil {
+		return nil, fmt.Errorf("failed to get clique snapshot: %w", err)
+	}
+
+	snapshot := &ports.CliqueSnapshot{
+		Number:  uint64(raw.Number),
+		Hash:    raw.Hash,
+		Signers: make([]common.Address, 0, len(raw.Signers)),
+		Recents: make(map[uint64]common.Address, len(raw.Recents)),
+		Votes:   make([]ports.CliqueVote, 0, len(raw.Votes)),
+		Tally:   make(map[common.Address]ports.CliqueTally, len(raw.Tally)),
+	}
+
+	for signer := range raw.Signers {
+		snapshot.Signers = append(snapshot.Signers, signer)
+	}
+	sortAddresses(snapshot.Signers)
+
+	for block, signer := range raw.Recents {
+		var number hexOrNumber
+		if err := number.UnmarshalJSON([]byte(block)); err != nil {
+			return nil, fmt.Errorf("failed to decode clique snapshot: %w", err)
+		}
+		snapshot.Recents[uint64(number)] = signer
+	}
+
+	for _, vote := range raw.Votes {
+		snapshot.Votes = append(snapshot.Votes, ports.CliqueVote{
+			Signer:    vote.Signer,
+			Block:     uint64(vote.Block),
+			Address:   vote.Address,
+			Authorize: vote.Authorize,
+		})
+	}
+
+	for address, tally := range raw.Tally {
+		snapshot.Tally[address] = ports.CliqueTally{
+			Authorize: tally.Authorize,
+			Votes:     tally.Votes,
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Propose fait voter le signer du node pour autoriser (ou retirer) une adresse (clique_propose)
+func (ec *EthereumClient) Propose(ctx context.Context, nodeURL string, address common.Address, authorize bool) error {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return err
+	}
+
+	if err := rpcClient.CallContext(ctx, nil, "clique_propose", address, authorize); err != nil {
+		return fmt.Errorf("failed to propose %s: %w", address.Hex(), err)
+	}
+
+	return nil
+}
+
+// Discard retire la proposition en cours du node pour une adresse (clique_discard)
+func (ec *EthereumClient) Discard(ctx context.Context, nodeURL string, address common.Address) error {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return err
+	}
+
+	if err := rpcClient.CallContext(ctx, nil, "clique_discard", address); err != nil {
+		return fmt.Errorf("failed to discard proposal for %s: %w", address.Hex(), err)
+	}
+
+	return nil
+}
+
+// cliqueSigner retrouve le validateur ayant scellé un bloc à partir de la signature en fin de extraData
+func cliqueSigner(header *types.Header) (common.Address, error) {
+	if len(header.Extra) < cliqueExtraSeal {
+		return common.Address{}, fmt.Errorf("block %d has no clique seal in extraData", header.Number)
+	}
+
+	signature := header.Extra[len(header.Extra)-cliqueExtraSeal:]
+	publicKey, err := crypto.Ecrecover(cliqueSealHash(header).Bytes(), signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover clique signer of block %d: %w", header.Number, err)
+	}
+
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(publicKey[1:])[12:])
+	return signer, nil
+}
+
+// cliqueSealHash calcule le hash signé par le validateur : l'en-tête sans la signature
+// (même encodage que consensus/clique, base fee inclus après London)
+func cliqueSealHash(header *types.Header) common.Hash {
+	fields := []interface{}{
+		header.ParentHash,
+		header.UncleHash,
+		header.Coinbase,
+		header.Root,
+		header.TxHash,
+		header.ReceiptHash,
+		header.Bloom,
+		header.Difficulty,
+		header.Number,
+		header.GasLimit,
+		header.GasUsed,
+		header.Time,
+		header.Extra[:len(header.Extra)-cliqueExtraSeal],
+		header.MixDigest,
+		header.Nonce,
+	}
+	if header.BaseFee != nil {
+		fields = append(fields, header.BaseFee)
+	}
+
+	encoded, _ := rlp.EncodeToBytes(fields)
+	return crypto.Keccak256Hash(encoded)
+}
+
+// sortAddresses trie des adresses dans l'ordre utilisé par Clique pour le tour de rôle
+func sortAddresses(addresses []common.Address) {
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+}
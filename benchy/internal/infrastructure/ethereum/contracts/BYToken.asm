@@ -0,0 +1,387 @@
+; BYToken - ERC20 minimal "Benchy" (BY), 18 décimales
+; Assemblé en BYToken.bin (init + runtime, labels relatifs au début du runtime)
+; Stockage : slot 2 = totalSupply
+;            balances[a]       = keccak256(a . 0)
+;            allowances[o][s]  = keccak256(s . keccak256(o . 1))
+; Mémoire  : 0x00-0x3f scratch keccak, 0x80 from, 0xa0 to, 0xc0 amount
+
+.init
+    ; initialSupply = 32 derniers octets du code (argument du constructeur)
+    PUSH1 0x20
+    PUSH1 0x20
+    CODESIZE
+    SUB
+    PUSH1 0x00
+    CODECOPY
+    PUSH1 0x00
+    MLOAD
+    DUP1
+    PUSH1 0x02
+    SSTORE
+    DUP1
+    PUSH1 0x80
+    MSTORE
+    CALLER
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x00
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    SSTORE
+    ; Transfer(0x0, msg.sender, initialSupply)
+    CALLER
+    PUSH1 0x00
+    PUSH32 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+    PUSH1 0x20
+    PUSH1 0x80
+    LOG3
+    ; retourner le code runtime
+    PUSH2 @@runtime_size
+    DUP1
+    PUSH2 @@runtime_offset
+    PUSH1 0x00
+    CODECOPY
+    PUSH1 0x00
+    RETURN
+
+.runtime
+    PUSH1 0x04
+    CALLDATASIZE
+    LT
+    PUSH2 @revert
+    JUMPI
+    PUSH1 0x00
+    CALLDATALOAD
+    PUSH1 0xe0
+    SHR
+    DUP1
+    PUSH4 0xa9059cbb
+    EQ
+    PUSH2 @transfer
+    JUMPI
+    DUP1
+    PUSH4 0x70a08231
+    EQ
+    PUSH2 @balanceOf
+    JUMPI
+    DUP1
+    PUSH4 0x23b872dd
+    EQ
+    PUSH2 @transferFrom
+    JUMPI
+    DUP1
+    PUSH4 0x095ea7b3
+    EQ
+    PUSH2 @approve
+    JUMPI
+    DUP1
+    PUSH4 0xdd62ed3e
+    EQ
+    PUSH2 @allowance
+    JUMPI
+    DUP1
+    PUSH4 0x18160ddd
+    EQ
+    PUSH2 @totalSupply
+    JUMPI
+    DUP1
+    PUSH4 0x313ce567
+    EQ
+    PUSH2 @decimals
+    JUMPI
+    DUP1
+    PUSH4 0x06fdde03
+    EQ
+    PUSH2 @name
+    JUMPI
+    DUP1
+    PUSH4 0x95d89b41
+    EQ
+    PUSH2 @symbol
+    JUMPI
+revert:
+    JUMPDEST
+    PUSH1 0x00
+    DUP1
+    REVERT
+
+returnWord:
+    JUMPDEST
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x20
+    PUSH1 0x00
+    RETURN
+
+returnTrue:
+    JUMPDEST
+    PUSH1 0x01
+    PUSH2 @returnWord
+    JUMP
+
+; transfer(address to, uint256 amount)
+transfer:
+    JUMPDEST
+    CALLER
+    PUSH1 0x80
+    MSTORE
+    PUSH1 0x04
+    CALLDATALOAD
+    PUSH1 0xa0
+    MSTORE
+    PUSH1 0x24
+    CALLDATALOAD
+    PUSH1 0xc0
+    MSTORE
+    PUSH2 @doTransfer
+    JUMP
+
+; transferFrom(address from, address to, uint256 amount)
+transferFrom:
+    JUMPDEST
+    PUSH1 0x04
+    CALLDATALOAD
+    PUSH1 0x80
+    MSTORE
+    PUSH1 0x24
+    CALLDATALOAD
+    PUSH1 0xa0
+    MSTORE
+    PUSH1 0x44
+    CALLDATALOAD
+    PUSH1 0xc0
+    MSTORE
+    ; slot allowances[from][msg.sender]
+    PUSH1 0x80
+    MLOAD
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x01
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    PUSH1 0x20
+    MSTORE
+    CALLER
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    DUP1
+    SLOAD
+    DUP1
+    PUSH1 0xc0
+    MLOAD
+    GT
+    PUSH2 @revert
+    JUMPI
+    ; allowance infinie : pas de décrément
+    DUP1
+    NOT
+    ISZERO
+    PUSH2 @transferFromUnlimited
+    JUMPI
+    PUSH1 0xc0
+    MLOAD
+    SWAP1
+    SUB
+    SWAP1
+    SSTORE
+    PUSH2 @doTransfer
+    JUMP
+transferFromUnlimited:
+    JUMPDEST
+    POP
+    POP
+    PUSH2 @doTransfer
+    JUMP
+
+; doTransfer : déplace mem[0xc0] de mem[0x80] vers mem[0xa0]
+doTransfer:
+    JUMPDEST
+    PUSH1 0x80
+    MLOAD
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x00
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    DUP1
+    SLOAD
+    DUP1
+    PUSH1 0xc0
+    MLOAD
+    GT
+    PUSH2 @revert
+    JUMPI
+    PUSH1 0xc0
+    MLOAD
+    SWAP1
+    SUB
+    SWAP1
+    SSTORE
+    PUSH1 0xa0
+    MLOAD
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x00
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    DUP1
+    SLOAD
+    PUSH1 0xc0
+    MLOAD
+    ADD
+    SWAP1
+    SSTORE
+    ; Transfer(from, to, amount)
+    PUSH1 0xa0
+    MLOAD
+    PUSH1 0x80
+    MLOAD
+    PUSH32 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+    PUSH1 0x20
+    PUSH1 0xc0
+    LOG3
+    PUSH2 @returnTrue
+    JUMP
+
+; approve(address spender, uint256 amount)
+approve:
+    JUMPDEST
+    CALLER
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x01
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x04
+    CALLDATALOAD
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    PUSH1 0x24
+    CALLDATALOAD
+    SWAP1
+    SSTORE
+    ; Approval(owner, spender, amount)
+    PUSH1 0x24
+    CALLDATALOAD
+    PUSH1 0xc0
+    MSTORE
+    PUSH1 0x04
+    CALLDATALOAD
+    CALLER
+    PUSH32 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925
+    PUSH1 0x20
+    PUSH1 0xc0
+    LOG3
+    PUSH2 @returnTrue
+    JUMP
+
+; balanceOf(address owner)
+balanceOf:
+    JUMPDEST
+    PUSH1 0x04
+    CALLDATALOAD
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x00
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    SLOAD
+    PUSH2 @returnWord
+    JUMP
+
+; allowance(address owner, address spender)
+allowance:
+    JUMPDEST
+    PUSH1 0x04
+    CALLDATALOAD
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x01
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    PUSH1 0x20
+    MSTORE
+    PUSH1 0x24
+    CALLDATALOAD
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x40
+    PUSH1 0x00
+    SHA3
+    SLOAD
+    PUSH2 @returnWord
+    JUMP
+
+totalSupply:
+    JUMPDEST
+    PUSH1 0x02
+    SLOAD
+    PUSH2 @returnWord
+    JUMP
+
+decimals:
+    JUMPDEST
+    PUSH1 0x12
+    PUSH2 @returnWord
+    JUMP
+
+; name() -> "Benchy"
+name:
+    JUMPDEST
+    PUSH1 0x20
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x06
+    PUSH1 0x20
+    MSTORE
+    PUSH32 0x42656e6368790000000000000000000000000000000000000000000000000000
+    PUSH1 0x40
+    MSTORE
+    PUSH1 0x60
+    PUSH1 0x00
+    RETURN
+
+; symbol() -> "BY"
+symbol:
+    JUMPDEST
+    PUSH1 0x20
+    PUSH1 0x00
+    MSTORE
+    PUSH1 0x02
+    PUSH1 0x20
+    MSTORE
+    PUSH32 0x4259000000000000000000000000000000000000000000000000000000000000
+    PUSH1 0x40
+    MSTORE
+    PUSH1 0x60
+    PUSH1 0x00
+    RETURN
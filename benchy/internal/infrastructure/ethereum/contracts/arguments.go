@@ -0,0 +1,201 @@
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AddressResolver convertit un argument de type address, pour accepter des alias (noms de nodes…)
+type AddressResolver func(value string) (common.Address, error)
+
+// FindMethod retourne une méthode par nom ("transfer") ou par signature ("transfer(address,uint256)")
+func FindMethod(contractABI abi.ABI, name string) (abi.Method, error) {
+	if method, exists := contractABI.Methods[name]; exists {
+		return method, nil
+	}
+
+	var available []string
+	for _, method := range contractABI.Methods {
+		if method.Sig == name {
+			return method, nil
+		}
+		available = append(available, method.Sig)
+	}
+	sort.Strings(available)
+
+	return abi.Method{}, fmt.Errorf("method %s not found in ABI (available: %s)", name, strings.Join(available, ", "))
+}
+
+// ParseArguments convertit les arguments texte de la ligne de commande selon les types de l'ABI
+func ParseArguments(arguments abi.Arguments, values []string, resolve AddressResolver) ([]interface{}, error) {
+	if len(values) != len(arguments) {
+		return nil, fmt.Errorf("expected %d argument(s) (%s), got %d", len(arguments), describeArguments(arguments), len(values))
+	}
+
+	parsed := make([]interface{}, len(values))
+	for i, argument := range arguments {
+		value, err := ParseArgument(argument.Type, values[i], resolve)
+		if err != nil {
+			name := argument.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", i)
+			}
+			return nil, fmt.Errorf("argument %s (%s): %w", name, argument.Type, err)
+		}
+		parsed[i] = value
+	}
+
+	return parsed, nil
+}
+
+// ParseArgument convertit une valeur texte vers le type Go attendu par l'encodeur ABI.
+// Les tableaux s'écrivent [a,b,c] ; les tuples ne sont pas pris en charge.
+func ParseArgument(t abi.Type, value string, resolve AddressResolver) (interface{}, error) {
+	value = strings.TrimSpace(value)
+
+	switch t.T {
+	case abi.AddressTy:
+		if resolve != nil {
+			return resolve(value)
+		}
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid address: %s", value)
+		}
+		return common.HexToAddress(value), nil
+
+	case abi.UintTy, abi.IntTy:
+		return parseInteger(t, value)
+
+	case abi.BoolTy:
+		return strconv.ParseBool(value)
+
+	case abi.StringTy:
+		return value, nil
+
+	case abi.BytesTy:
+		return hexutil.Decode(value)
+
+	case abi.FixedBytesTy:
+		data, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > t.Size {
+			return nil, fmt.Errorf("%d bytes do not fit in bytes%d", len(data), t.Size)
+		}
+		array := reflect.New(t.GetType()).Elem()
+		reflect.Copy(array, reflect.ValueOf(data))
+		return array.Interface(), nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		elements := splitList(value)
+		if t.T == abi.ArrayTy && len(elements) != t.Size {
+			return nil, fmt.Errorf("expected %d elements, got %d", t.Size, len(elements))
+		}
+
+		var list reflect.Value
+		if t.T == abi.SliceTy {
+			list = reflect.MakeSlice(t.GetType(), len(elements), len(elements))
+		} else {
+			list = reflect.New(t.GetType()).Elem()
+		}
+		for i, element := range elements {
+			parsed, err := ParseArgument(*t.Elem, element, resolve)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			list.Index(i).Set(reflect.ValueOf(parsed))
+		}
+		return list.Interface(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported argument type %s", t)
+}
+
+// parseInteger convertit un entier décimal ou hexadécimal vers le type Go de l'ABI
+// (uint8…uint64 / int8…int64, *big.Int au-delà de 64 bits)
+func parseInteger(t abi.Type, value string) (interface{}, error) {
+	number, ok := new(big.Int).SetString(value, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer: %s", value)
+	}
+
+	if t.T == abi.UintTy && number.Sign() < 0 {
+		return nil, fmt.Errorf("negative value for %s", t)
+	}
+	bits := number.BitLen()
+	if t.T == abi.IntTy {
+		if number.Sign() < 0 {
+			// -2^(n-1) tient sur n bits
+			bits = new(big.Int).Sub(new(big.Int).Neg(number), big.NewInt(1)).BitLen()
+		}
+		bits++ // Bit de signe
+	}
+	if bits > t.Size {
+		return nil, fmt.Errorf("%s overflows %s", value, t)
+	}
+
+	goType := t.GetType()
+	if goType == reflect.TypeOf(&big.Int{}) {
+		return number, nil
+	}
+
+	integer := reflect.New(goType).Elem()
+	if t.T == abi.UintTy {
+		integer.SetUint(number.Uint64())
+	} else {
+		integer.SetInt(number.Int64())
+	}
+	return integer.Interface(), nil
+}
+
+// splitList découpe "[a,b,c]" (ou "a,b,c") en éléments, en respectant les crochets imbriqués
+func splitList(value string) []string {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+		value = value[1 : len(value)-1]
+	}
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	var elements []string
+	depth, start := 0, 0
+	for i, r := range value {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				elements = append(elements, strings.Trim(strings.TrimSpace(value[start:i]), `"`))
+				start = i + 1
+			}
+		}
+	}
+	elements = append(elements, strings.Trim(strings.TrimSpace(value[start:]), `"`))
+
+	return elements
+}
+
+// describeArguments liste les arguments attendus ("address to, uint256 amount")
+func describeArguments(arguments abi.Arguments) string {
+	if len(arguments) == 0 {
+		return "none"
+	}
+
+	described := make([]string, len(arguments))
+	for i, argument := range arguments {
+		described[i] = strings.TrimSpace(argument.Type.String() + " " + argument.Name)
+	}
+	return strings.Join(described, ", ")
+}
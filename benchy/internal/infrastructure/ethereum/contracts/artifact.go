@@ -0,0 +1,205 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Artifact représente un contrat compilé : son ABI et son bytecode de déploiement
+type Artifact struct {
+	Name     string
+	ABI      abi.ABI
+	RawABI   json.RawMessage // ABI JSON d'origine, pour la réenregistrer
+	Bytecode []byte          // Vide si l'artifact ne contient que l'ABI
+}
+
+// artifactJSON couvre les formats Hardhat/Truffle, Foundry et solc (--combined-json ou standard JSON)
+type artifactJSON struct {
+	ContractName string                     `json:"contractName"` // Hardhat, Truffle
+	ABI          json.RawMessage            `json:"abi"`
+	Bytecode     json.RawMessage            `json:"bytecode"` // "0x…" (Hardhat) ou {"object": "0x…"} (Foundry)
+	Bin          string                     `json:"bin"`      // Entrée de solc --combined-json
+	EVM          *evmJSON                   `json:"evm"`      // Entrée de la sortie standard JSON de solc
+	Contracts    map[string]json.RawMessage `json:"contracts"`
+}
+
+// evmJSON est la section evm d'un contrat dans la sortie standard JSON de solc
+type evmJSON struct {
+	Bytecode struct {
+		Object string `json:"object"`
+	} `json:"bytecode"`
+}
+
+// LoadArtifact charge un contrat depuis un artifact Foundry, Hardhat ou solc, ou depuis une paire
+// de fichiers X.abi / X.bin. name choisit le contrat quand le fichier en contient plusieurs.
+func LoadArtifact(path string, name string) (*Artifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	// ABI seule (fichier .abi ou tableau JSON), avec le bytecode éventuel dans le .bin voisin
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		artifact := &Artifact{Name: base}
+		if err := artifact.setABI(trimmed); err != nil {
+			return nil, err
+		}
+
+		binPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".bin"
+		if bin, err := os.ReadFile(binPath); err == nil {
+			if artifact.Bytecode, err = decodeBytecode(string(bin)); err != nil {
+				return nil, fmt.Errorf("%s: %w", binPath, err)
+			}
+		}
+		return artifact, nil
+	}
+
+	var raw artifactJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse artifact %s: %w", path, err)
+	}
+
+	if len(raw.Contracts) > 0 {
+		return selectSolcContract(raw.Contracts, name)
+	}
+
+	if raw.ContractName != "" {
+		base = raw.ContractName
+	}
+	return raw.toArtifact(base)
+}
+
+// setABI parse l'ABI, fournie en JSON ou en chaîne JSON (anciennes versions de solc --combined-json)
+func (a *Artifact) setABI(raw json.RawMessage) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return fmt.Errorf("artifact %s has no ABI", a.Name)
+	}
+
+	if raw[0] == '"' {
+		var encoded string
+		if err := json.Unmarshal(raw, &encoded); err != nil {
+			return fmt.Errorf("invalid ABI for %s: %w", a.Name, err)
+		}
+		raw = json.RawMessage(encoded)
+	}
+
+	parsed, err := abi.JSON(bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("invalid ABI for %s: %w", a.Name, err)
+	}
+
+	a.ABI = parsed
+	a.RawABI = raw
+	return nil
+}
+
+// toArtifact extrait ABI et bytecode d'une entrée de contrat, quel que soit son format
+func (raw *artifactJSON) toArtifact(name string) (*Artifact, error) {
+	artifact := &Artifact{Name: name}
+	if err := artifact.setABI(raw.ABI); err != nil {
+		return nil, err
+	}
+
+	var code string
+	switch {
+	case raw.Bin != "":
+		code = raw.Bin
+	case raw.EVM != nil:
+		code = raw.EVM.Bytecode.Object
+	case len(raw.Bytecode) > 0 && raw.Bytecode[0] == '"':
+		if err := json.Unmarshal(raw.Bytecode, &code); err != nil {
+			return nil, fmt.Errorf("invalid bytecode for %s: %w", name, err)
+		}
+	case len(raw.Bytecode) > 0 && raw.Bytecode[0] == '{':
+		var object struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(raw.Bytecode, &object); err != nil {
+			return nil, fmt.Errorf("invalid bytecode for %s: %w", name, err)
+		}
+		code = object.Object
+	}
+
+	bytecode, err := decodeBytecode(code)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	artifact.Bytecode = bytecode
+
+	return artifact, nil
+}
+
+// selectSolcContract choisit un contrat dans une sortie solc :
+// {"fichier:Nom": {...}} (--combined-json) ou {"fichier": {"Nom": {...}}} (standard JSON)
+func selectSolcContract(contracts map[string]json.RawMessage, name string) (*Artifact, error) {
+	entries := make(map[string]*artifactJSON)
+	for key, value := range contracts {
+		if index := strings.LastIndex(key, ":"); index >= 0 {
+			var entry artifactJSON
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return nil, fmt.Errorf("failed to parse contract %s: %w", key, err)
+			}
+			entries[key[index+1:]] = &entry
+			continue
+		}
+
+		var byName map[string]*artifactJSON
+		if err := json.Unmarshal(value, &byName); err != nil {
+			return nil, fmt.Errorf("failed to parse contracts of %s: %w", key, err)
+		}
+		for contractName, entry := range byName {
+			entries[contractName] = entry
+		}
+	}
+
+	names := make([]string, 0, len(entries))
+	for contractName := range entries {
+		names = append(names, contractName)
+	}
+	sort.Strings(names)
+
+	if name == "" {
+		if len(names) != 1 {
+			return nil, fmt.Errorf("artifact contains several contracts, choose one with --name: %s", strings.Join(names, ", "))
+		}
+		name = names[0]
+	}
+
+	entry, exists := entries[name]
+	if !exists {
+		return nil, fmt.Errorf("contract %s not found in artifact (available: %s)", name, strings.Join(names, ", "))
+	}
+
+	return entry.toArtifact(name)
+}
+
+// decodeBytecode décode un bytecode hexadécimal, avec ou sans préfixe 0x
+func decodeBytecode(code string) ([]byte, error) {
+	code = strings.TrimSpace(code)
+	if code == "" || code == "0x" {
+		return nil, nil
+	}
+	// Emplacements de bibliothèques non liées : __$…$__ (solc) ou __Nom____ (anciens formats)
+	if strings.Contains(code, "__") {
+		return nil, fmt.Errorf("bytecode has unlinked library references")
+	}
+	if !strings.HasPrefix(code, "0x") {
+		code = "0x" + code
+	}
+
+	bytecode, err := hexutil.Decode(code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode: %w", err)
+	}
+	return bytecode, nil
+}
@@ -0,0 +1,158 @@
+//go:build ignore
+
+// asm assemble un contrat écrit en mnémoniques EVM (format de BYToken.asm) en bytecode de déploiement hex :
+//
+//	go run asm.go BYToken.asm BYToken.bin
+//
+// Le fichier a deux sections, .init puis .runtime. Une ligne porte une instruction (PUSHn avec sa valeur
+// hex ou une référence) ou un label ("name:") ; ";" ouvre un commentaire. Les références @label valent la
+// position du label depuis le début du runtime, @@runtime_offset et @@runtime_size placent le runtime
+// derrière le code d'init.
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// instruction est une ligne assemblée ; ref est la référence à résoudre une fois les tailles connues
+type instruction struct {
+	op    vm.OpCode
+	value []byte
+	ref   string
+	line  int
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: go run asm.go <source.asm> <output.bin>")
+		os.Exit(2)
+	}
+	code, err := assemble(os.Args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(os.Args[2], []byte(hex.EncodeToString(code)+"\n"), 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// assemble lit les deux sections, calcule les labels du runtime puis résout les références
+func assemble(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sections := map[string][]instruction{}
+	labels := map[string]int{}
+	offsets := map[string]int{}
+	section := ""
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), ";")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case fields[0] == ".init" || fields[0] == ".runtime":
+			section = fields[0]
+			continue
+		case section == "":
+			return nil, fmt.Errorf("%s:%d: instruction outside .init and .runtime", path, line)
+		case strings.HasSuffix(fields[0], ":"):
+			if section != ".runtime" {
+				return nil, fmt.Errorf("%s:%d: labels are only supported in .runtime", path, line)
+			}
+			labels[strings.TrimSuffix(fields[0], ":")] = offsets[section]
+			continue
+		}
+
+		inst, err := parseInstruction(fields, line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		sections[section] = append(sections[section], inst)
+		offsets[section] += 1 + len(inst.value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	refs := map[string]int{
+		"@runtime_offset": offsets[".init"],
+		"@runtime_size":   offsets[".runtime"],
+	}
+	for name, offset := range labels {
+		refs[name] = offset
+	}
+
+	var code []byte
+	for _, name := range []string{".init", ".runtime"} {
+		for _, inst := range sections[name] {
+			if inst.ref != "" {
+				value, found := refs[inst.ref]
+				if !found {
+					return nil, fmt.Errorf("%s:%d: unknown reference @%s", path, inst.line, inst.ref)
+				}
+				if value >= 1<<(8*len(inst.value)) {
+					return nil, fmt.Errorf("%s:%d: @%s (%d) does not fit in %s", path, inst.line, inst.ref, value, inst.op)
+				}
+				for i := range inst.value {
+					inst.value[len(inst.value)-1-i] = byte(value >> (8 * i))
+				}
+			}
+			code = append(append(code, byte(inst.op)), inst.value...)
+		}
+	}
+	return code, nil
+}
+
+// parseInstruction lit un opcode et, pour PUSHn, sa valeur sur n octets
+func parseInstruction(fields []string, line int) (instruction, error) {
+	name := strings.ToUpper(fields[0])
+	if name == "SHA3" {
+		name = "KECCAK256"
+	}
+	op := vm.StringToOp(name)
+	if op == 0 && name != "STOP" {
+		return instruction{}, fmt.Errorf("unknown opcode %s", fields[0])
+	}
+	inst := instruction{op: op, line: line}
+
+	if !op.IsPush() {
+		if len(fields) != 1 {
+			return instruction{}, fmt.Errorf("%s takes no argument", name)
+		}
+		return inst, nil
+	}
+
+	if len(fields) != 2 {
+		return instruction{}, fmt.Errorf("%s needs one argument", name)
+	}
+	size, _ := strconv.Atoi(strings.TrimPrefix(name, "PUSH"))
+	inst.value = make([]byte, size)
+
+	if ref, isRef := strings.CutPrefix(fields[1], "@"); isRef {
+		inst.ref = ref
+		return inst, nil
+	}
+	value, err := hex.DecodeString(strings.TrimPrefix(fields[1], "0x"))
+	if err != nil || len(value) > size {
+		return instruction{}, fmt.Errorf("invalid %s value %s", name, fields[1])
+	}
+	copy(inst.value[size-len(value):], value)
+	return inst, nil
+}
@@ -0,0 +1,114 @@
+// Package contracts embarque les smart contracts livrés avec benchy et leurs bindings Go.
+//
+// BYToken.asm est la source du token BY : asm.go l'assemble en BYToken.bin, puis abigen génère
+// by_token_binding.go depuis BYToken.abi et BYToken.bin :
+//
+//	go generate ./internal/infrastructure/ethereum/contracts
+package contracts
+
+//go:generate go run asm.go BYToken.asm BYToken.bin
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen@v1.10.26 --abi BYToken.abi --bin BYToken.bin --pkg contracts --type BYToken --out by_token_binding.go
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Métadonnées du token BY
+const (
+	BYTokenName     = "Benchy"
+	BYTokenSymbol   = "BY"
+	BYTokenDecimals = 18
+)
+
+// byTokenABI est l'ABI parsée du binding généré, partagée par les helpers d'encodage
+var byTokenABI = mustParseABI(BYTokenMetaData.ABI)
+
+// BYTokenParsedABI retourne l'ABI parsée du token BY
+func BYTokenParsedABI() abi.ABI {
+	return byTokenABI
+}
+
+// BYTokenDeployCode retourne le code de déploiement avec l'offre initiale en argument du constructeur
+func BYTokenDeployCode(initialSupply *big.Int) ([]byte, error) {
+	args, err := byTokenABI.Pack("", initialSupply)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack constructor: %w", err)
+	}
+
+	return append(common.FromHex(BYTokenMetaData.Bin), args...), nil
+}
+
+// PackTransfer encode un appel transfer(to, amount)
+func PackTransfer(to common.Address, amount *big.Int) ([]byte, error) {
+	return byTokenABI.Pack("transfer", to, amount)
+}
+
+// PackTransferFrom encode un appel transferFrom(from, to, amount)
+func PackTransferFrom(from, to common.Address, amount *big.Int) ([]byte, error) {
+	return byTokenABI.Pack("transferFrom", from, to, amount)
+}
+
+// PackApprove encode un appel approve(spender, amount)
+func PackApprove(spender common.Address, amount *big.Int) ([]byte, error) {
+	return byTokenABI.Pack("approve", spender, amount)
+}
+
+// PackBalanceOf encode un appel balanceOf(owner)
+func PackBalanceOf(owner common.Address) ([]byte, error) {
+	return byTokenABI.Pack("balanceOf", owner)
+}
+
+// PackAllowance encode un appel allowance(owner, spender)
+func PackAllowance(owner, spender common.Address) ([]byte, error) {
+	return byTokenABI.Pack("allowance", owner, spender)
+}
+
+// UnpackUint256 décode le retour uint256 d'une méthode (balanceOf, allowance, totalSupply)
+func UnpackUint256(method string, data []byte) (*big.Int, error) {
+	values, err := byTokenABI.Unpack(method, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s: %w", method, err)
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("unexpected %s result", method)
+	}
+
+	value, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s result type %T", method, values[0])
+	}
+
+	return value, nil
+}
+
+// ToTokenUnits convertit un montant entier de tokens en unités de base (18 décimales)
+func ToTokenUnits(amount int64) *big.Int {
+	units := new(big.Int).Exp(big.NewInt(10), big.NewInt(BYTokenDecimals), nil)
+	return units.Mul(units, big.NewInt(amount))
+}
+
+// FromTokenUnits convertit des unités de base en nombre de tokens
+func FromTokenUnits(units *big.Int) float64 {
+	if units == nil {
+		return 0
+	}
+
+	value := new(big.Float).SetInt(units)
+	value.Quo(value, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(BYTokenDecimals), nil)))
+	result, _ := value.Float64()
+	return result
+}
+
+// mustParseABI parse une ABI embarquée (erreur de build si invalide)
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded ABI: %v", err))
+	}
+	return parsed
+}
@@ -0,0 +1,126 @@
+package ethereum
+
+import (
+	"fmt"
+	"sync"
+
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EventDecoder transforme les logs bruts en événements nommés à partir des ABI enregistrées
+type EventDecoder struct {
+	events map[common.Hash][]abi.Event // par topic0 (ID de l'événement)
+	mutex  sync.RWMutex
+}
+
+// NewEventDecoder crée un décodeur avec les événements ERC20 (Transfer, Approval) déjà enregistrés
+func NewEventDecoder() *EventDecoder {
+	decoder := &EventDecoder{
+		events: make(map[common.Hash][]abi.Event),
+	}
+	decoder.RegisterABI(contracts.BYTokenParsedABI())
+
+	return decoder
+}
+
+// RegisterABI enregistre tous les événements d'une ABI
+func (ed *EventDecoder) RegisterABI(contractABI abi.ABI) {
+	ed.mutex.Lock()
+	defer ed.mutex.Unlock()
+
+	for _, event := range contractABI.Events {
+		if event.Anonymous {
+			continue
+		}
+
+		// Une même signature peut exister avec des arguments indexés différemment (ERC20 vs ERC721)
+		known := ed.events[event.ID]
+		duplicate := false
+		for _, existing := range known {
+			if indexedCount(existing) == indexedCount(event) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			ed.events[event.ID] = append(known, event)
+		}
+	}
+}
+
+// Decode décode un log ; retourne false si aucune ABI enregistrée ne le reconnaît
+func (ed *EventDecoder) Decode(log ports.LogEntry, logIndex int) (*ports.DecodedEvent, bool, error) {
+	if len(log.Topics) == 0 {
+		return nil, false, nil
+	}
+
+	ed.mutex.RLock()
+	candidates := ed.events[log.Topics[0]]
+	ed.mutex.RUnlock()
+
+	for _, event := range candidates {
+		if indexedCount(event) != len(log.Topics)-1 {
+			continue
+		}
+
+		args := make(map[string]interface{})
+		if len(log.Data) > 0 {
+			if err := event.Inputs.NonIndexed().UnpackIntoMap(args, log.Data); err != nil {
+				return nil, false, fmt.Errorf("failed to decode %s data: %w", event.Name, err)
+			}
+		}
+
+		var indexed abi.Arguments
+		for _, input := range event.Inputs {
+			if input.Indexed {
+				indexed = append(indexed, input)
+			}
+		}
+		if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+			return nil, false, fmt.Errorf("failed to decode %s topics: %w", event.Name, err)
+		}
+
+		return &ports.DecodedEvent{
+			Address:   log.Address,
+			Name:      event.Name,
+			Signature: event.Sig,
+			Args:      args,
+			LogIndex:  logIndex,
+		}, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// DecodeReceipt remplit receipt.Events avec les logs reconnus
+func (ed *EventDecoder) DecodeReceipt(receipt *ports.TransactionReceipt) {
+	receipt.Events = nil
+
+	for i, log := range receipt.Logs {
+		// Un log mal formé reste disponible brut dans receipt.Logs
+		event, ok, err := ed.Decode(log, i)
+		if err != nil || !ok {
+			continue
+		}
+		receipt.Events = append(receipt.Events, *event)
+	}
+}
+
+// RegisterABI enregistre une ABI dont les événements seront décodés dans les reçus
+func (ec *EthereumClient) RegisterABI(contractABI abi.ABI) {
+	ec.events.RegisterABI(contractABI)
+}
+
+// indexedCount retourne le nombre d'arguments indexés (topics) d'un événement
+func indexedCount(event abi.Event) int {
+	count := 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,233 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// minGasTipCap est le tip plancher : les validateurs Geth ignorent les tips sous --miner.gasprice (1 gwei),
+	// alors que Nethermind suggère 0 sur une chaîne au repos
+	minGasTipCap = params.GWei
+	// contractGasMarginPercent est la marge ajoutée à l'estimation des appels de contrat,
+	// l'estimation de Nethermind étant parfois juste en dessous du gas réellement consommé
+	contractGasMarginPercent = 20
+)
+
+// SetFeePolicy définit la politique de frais des transactions qui n'en précisent pas
+func (ec *EthereumClient) SetFeePolicy(policy entities.FeePolicy) {
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+
+	ec.feePolicy = policy
+}
+
+// FeePolicy retourne la politique de frais par défaut du client
+func (ec *EthereumClient) FeePolicy() entities.FeePolicy {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+
+	return ec.feePolicy
+}
+
+// EstimateGas estime la limite de gas d'une transaction (eth_estimateGas, avec marge pour les contrats)
+func (ec *EthereumClient) EstimateGas(ctx context.Context, nodeURL string, tx *entities.Transaction) (uint64, error) {
+	var to *common.Address
+	if tx.Type != entities.TxTypeContract {
+		address := tx.To
+		to = &address
+	}
+
+	return ec.estimateGas(ctx, nodeURL, tx, to)
+}
+
+// SuggestGasTipCap retourne le tip suggéré par le node (eth_maxPriorityFeePerGas), avec un plancher
+func (ec *EthereumClient) SuggestGasTipCap(ctx context.Context, nodeURL string) (*big.Int, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var tip hexutil.Big
+	if err := rpcClient.CallContext(ctx, &tip, "eth_maxPriorityFeePerGas"); err != nil {
+		// Méthode absente : tip = gasPrice - baseFee
+		fallback, fallbackErr := ec.tipFromGasPrice(ctx, nodeURL)
+		if fallbackErr != nil {
+			return nil, fmt.Errorf("failed to suggest gas tip cap: %w", err)
+		}
+		tip = hexutil.Big(*fallback)
+	}
+
+	result := tip.ToInt()
+	if result.Cmp(big.NewInt(minGasTipCap)) < 0 {
+		result = big.NewInt(minGasTipCap)
+	}
+
+	return result, nil
+}
+
+// SuggestGasPrice retourne le prix du gas suggéré par le node (eth_gasPrice), pour les transactions legacy
+func (ec *EthereumClient) SuggestGasPrice(ctx context.Context, nodeURL string) (*big.Int, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest gas price: %w", err)
+	}
+
+	return gasPrice, nil
+}
+
+// SuggestFees calcule tip et fee cap selon la politique, à partir du base fee du dernier bloc
+func (ec *EthereumClient) SuggestFees(ctx context.Context, nodeURL string, policy entities.FeePolicy) (*ports.FeeSuggestion, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tip, err := ec.SuggestGasTipCap(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest header: %w", err)
+	}
+
+	return newFeeSuggestion(tip, head.BaseFee, policy), nil
+}
+
+// newFeeSuggestion applique une politique au tip suggéré et au base fee du dernier bloc
+func newFeeSuggestion(tip *big.Int, baseFee *big.Int, policy entities.FeePolicy) *ports.FeeSuggestion {
+	tip = multiply(tip, policy.TipMultiplier)
+
+	// feeCap = k * baseFee + tip, pour survivre à quelques blocs pleins
+	feeCap := new(big.Int).Set(tip)
+	if baseFee != nil {
+		feeCap.Add(feeCap, multiply(baseFee, policy.BaseFeeMultiplier))
+	}
+
+	return &ports.FeeSuggestion{
+		BaseFee:   baseFee,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Policy:    policy,
+	}
+}
+
+// fillFees renseigne les frais manquants selon la politique de la transaction (ou celle du client)
+func (ec *EthereumClient) fillFees(ctx context.Context, nodeURL string, tx *entities.Transaction) error {
+	return completeFees(tx, ec.FeePolicy(), func(policy entities.FeePolicy) (*ports.FeeSuggestion, error) {
+		return ec.SuggestFees(ctx, nodeURL, policy)
+	})
+}
+
+// completeFees renseigne tip et fee cap manquants d'une transaction EIP-1559 à partir des frais suggérés
+// pour sa politique (defaultPolicy si elle n'en précise pas)
+func completeFees(tx *entities.Transaction, defaultPolicy entities.FeePolicy, suggest func(entities.FeePolicy) (*ports.FeeSuggestion, error)) error {
+	if !tx.IsDynamicFee() || (tx.GasTipCap != nil && tx.GasFeeCap != nil) {
+		return nil
+	}
+
+	policy := defaultPolicy
+	if tx.FeePolicy != nil {
+		policy = *tx.FeePolicy
+	}
+
+	fees, err := suggest(policy)
+	if err != nil {
+		return err
+	}
+
+	if tx.GasTipCap == nil {
+		tx.GasTipCap = fees.GasTipCap
+	}
+	if tx.GasFeeCap == nil {
+		tx.GasFeeCap = fees.GasFeeCap
+	}
+
+	// Un fee cap imposé ne peut pas être inférieur au tip
+	if tx.GasFeeCap.Cmp(tx.GasTipCap) < 0 {
+		tx.GasFeeCap = new(big.Int).Set(tx.GasTipCap)
+	}
+
+	return nil
+}
+
+// estimateGas appelle eth_estimateGas ; to vaut nil pour un déploiement
+func (ec *EthereumClient) estimateGas(ctx context.Context, nodeURL string, tx *entities.Transaction, to *common.Address) (uint64, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return 0, err
+	}
+
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  tx.From,
+		To:    to,
+		Value: tx.Value,
+		Data:  tx.Data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+
+	return withGasMargin(gas, tx.Data), nil
+}
+
+// withGasMargin ajoute la marge des appels de contrat ; les transferts simples consomment exactement l'estimation
+func withGasMargin(gas uint64, data []byte) uint64 {
+	if len(data) > 0 {
+		gas += gas * contractGasMarginPercent / 100
+	}
+	return gas
+}
+
+// tipFromGasPrice déduit un tip de eth_gasPrice pour les nodes sans eth_maxPriorityFeePerGas
+func (ec *EthereumClient) tipFromGasPrice(ctx context.Context, nodeURL string) (*big.Int, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tip := new(big.Int).Set(gasPrice)
+	if head.BaseFee != nil {
+		tip.Sub(tip, head.BaseFee)
+	}
+	if tip.Sign() < 0 {
+		tip.SetInt64(0)
+	}
+
+	return tip, nil
+}
+
+// multiply applique un facteur flottant à un montant en wei
+func multiply(value *big.Int, factor float64) *big.Int {
+	if factor == 1 {
+		return new(big.Int).Set(value)
+	}
+
+	result, _ := new(big.Float).Mul(new(big.Float).SetInt(value), big.NewFloat(factor)).Int(nil)
+	return result
+}
@@ -0,0 +1,201 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// defaultNonceResyncInterval est l'intervalle après lequel un compte inactif est revérifié
+const defaultNonceResyncInterval = 30 * time.Second
+
+// NonceSource retourne le nonce pending d'un compte (eth_getTransactionCount "pending")
+type NonceSource func(ctx context.Context) (uint64, error)
+
+// NonceManager distribue les nonces par adresse pour les envois concurrents
+type NonceManager struct {
+	accounts       map[common.Address]*accountNonce
+	resyncInterval time.Duration
+	mutex          sync.Mutex
+}
+
+// accountNonce représente l'état local des nonces d'un compte
+type accountNonce struct {
+	next       uint64
+	inFlight   map[uint64]bool // nonces distribués mais pas encore diffusés
+	free       map[uint64]bool // nonces rendus sous next : des trous à reboucher avant d'avancer
+	synced     bool
+	lastSyncAt time.Time
+	mutex      sync.Mutex
+}
+
+// NewNonceManager crée un nouveau gestionnaire de nonces
+func NewNonceManager() *NonceManager {
+	return &NonceManager{
+		accounts:       make(map[common.Address]*accountNonce),
+		resyncInterval: defaultNonceResyncInterval,
+	}
+}
+
+// Acquire réserve le prochain nonce d'un compte
+func (nm *NonceManager) Acquire(ctx context.Context, address common.Address, source NonceSource) (uint64, error) {
+	account := nm.account(address)
+
+	account.mutex.Lock()
+	defer account.mutex.Unlock()
+
+	// Resynchroniser au premier usage, ou quand le compte est au repos depuis un moment
+	if !account.synced || (len(account.inFlight) == 0 && time.Since(account.lastSyncAt) > nm.resyncInterval) {
+		if err := account.sync(ctx, source); err != nil {
+			return 0, err
+		}
+	}
+
+	// Reboucher d'abord le plus petit trou, sans quoi les envois suivants restent bloqués derrière
+	nonce, found := account.lowestFree()
+	if found {
+		delete(account.free, nonce)
+	} else {
+		nonce = account.next
+		account.next++
+	}
+	account.inFlight[nonce] = true
+
+	return nonce, nil
+}
+
+// Commit signale que la transaction portant ce nonce a été acceptée par le node
+func (nm *NonceManager) Commit(address common.Address, nonce uint64) {
+	account := nm.account(address)
+
+	account.mutex.Lock()
+	defer account.mutex.Unlock()
+
+	delete(account.inFlight, nonce)
+}
+
+// Release rend un nonce dont la transaction n'a pas été acceptée
+func (nm *NonceManager) Release(address common.Address, nonce uint64, sendErr error) {
+	account := nm.account(address)
+
+	account.mutex.Lock()
+	defer account.mutex.Unlock()
+
+	delete(account.inFlight, nonce)
+
+	// Le nonce redevient libre : dernier distribué, le compteur recule (avec les trous qui le précèdent),
+	// sinon il reste un trou à reboucher par le prochain Acquire
+	account.free[nonce] = true
+	for account.next > 0 && account.free[account.next-1] {
+		account.next--
+		delete(account.free, account.next)
+	}
+
+	// Le node a un avis différent sur les nonces : repartir de son état au prochain Acquire
+	if IsNonceError(sendErr) {
+		account.synced = false
+	}
+}
+
+// account retourne (ou crée) l'état local d'un compte
+func (nm *NonceManager) account(address common.Address) *accountNonce {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	account, exists := nm.accounts[address]
+	if !exists {
+		account = &accountNonce{inFlight: make(map[uint64]bool), free: make(map[uint64]bool)}
+		nm.accounts[address] = account
+	}
+
+	return account
+}
+
+// sync aligne le compteur local sur le nonce pending du node. Un nonce en cours d'envoi n'est jamais
+// redistribué : le compteur ne redescend pas sous le plus haut nonce en vol.
+func (an *accountNonce) sync(ctx context.Context, source NonceSource) error {
+	pending, err := source(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to sync nonce: %w", err)
+	}
+
+	switch {
+	case len(an.inFlight) == 0 && (!an.synced || pending < an.next):
+		// Rien en vol : le node fait foi, y compris quand il a perdu des transactions (redémarrage,
+		// txpool vidé)
+		an.next = pending
+		an.free = make(map[uint64]bool)
+	case pending > an.next:
+		// Des transactions ont été envoyées en dehors de benchy
+		an.next = pending
+	case !an.synced:
+		// Erreur de nonce avec des envois en cours : au-delà du node, mais jamais sous un nonce en vol
+		an.next = max(pending, an.highestInFlight()+1)
+	}
+
+	// Les trous que le node a déjà consommés ne sont plus à reboucher
+	for nonce := range an.free {
+		if nonce < pending || nonce >= an.next {
+			delete(an.free, nonce)
+		}
+	}
+
+	an.synced = true
+	an.lastSyncAt = time.Now()
+
+	return nil
+}
+
+// lowestFree retourne le plus petit nonce libre sous next
+func (an *accountNonce) lowestFree() (uint64, bool) {
+	lowest, found := uint64(0), false
+	for nonce := range an.free {
+		if !found || nonce < lowest {
+			lowest, found = nonce, true
+		}
+	}
+	return lowest, found
+}
+
+// highestInFlight retourne le plus grand nonce en vol ; l'appelant vérifie qu'il y en a un
+func (an *accountNonce) highestInFlight() uint64 {
+	var highest uint64
+	for nonce := range an.inFlight {
+		highest = max(highest, nonce)
+	}
+	return highest
+}
+
+// IsNonceError retourne true si l'erreur du node concerne le nonce (Geth ou Nethermind). Une transaction
+// déjà connue du node n'en fait pas partie : voir isAlreadyKnownError.
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, pattern := range []string{
+		"nonce too low",
+		"nonce too high",
+		"oldnonce",
+		"noncegap",
+		"nonce too far in future",
+	} {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAlreadyKnownError détecte une transaction déjà présente dans le txpool du node (Geth : "already known",
+// Nethermind : "AlreadyKnown") : elle a été acceptée, par exemple lors d'un envoi dont la réponse s'est perdue
+func isAlreadyKnownError(err error) bool {
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "already known") || strings.Contains(message, "alreadyknown")
+}
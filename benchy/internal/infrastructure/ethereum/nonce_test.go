@@ -0,0 +1,174 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var nonceTestAddress = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+
+// fixedSource simule un node dont le nonce pending vaut *pending
+func fixedSource(pending *uint64) NonceSource {
+	return func(ctx context.Context) (uint64, error) {
+		return *pending, nil
+	}
+}
+
+// acquireN réserve n nonces à la suite
+func acquireN(t *testing.T, nm *NonceManager, source NonceSource, n int) []uint64 {
+	t.Helper()
+	nonces := make([]uint64, n)
+	for i := range nonces {
+		nonce, err := nm.Acquire(context.Background(), nonceTestAddress, source)
+		if err != nil {
+			t.Fatalf("Acquire: %v", err)
+		}
+		nonces[i] = nonce
+	}
+	return nonces
+}
+
+func TestNonceManagerConcurrentAcquire(t *testing.T) {
+	nm := NewNonceManager()
+	pending := uint64(5)
+	source := fixedSource(&pending)
+
+	const senders = 64
+	nonces := make(chan uint64, senders)
+	var wg sync.WaitGroup
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := nm.Acquire(context.Background(), nonceTestAddress, source)
+			if err != nil {
+				t.Errorf("Acquire: %v", err)
+				return
+			}
+			nonces <- nonce
+		}()
+	}
+	wg.Wait()
+	close(nonces)
+
+	seen := make(map[uint64]bool)
+	for nonce := range nonces {
+		if seen[nonce] {
+			t.Fatalf("nonce %d handed out twice", nonce)
+		}
+		if nonce < pending || nonce >= pending+senders {
+			t.Fatalf("nonce %d outside [%d, %d)", nonce, pending, pending+senders)
+		}
+		seen[nonce] = true
+	}
+	if len(seen) != senders {
+		t.Fatalf("%d distinct nonces, want %d", len(seen), senders)
+	}
+}
+
+func TestNonceManagerReleaseLast(t *testing.T) {
+	nm := NewNonceManager()
+	pending := uint64(0)
+	source := fixedSource(&pending)
+
+	acquireN(t, nm, source, 2)
+	nm.Release(nonceTestAddress, 1, errors.New("rejected"))
+
+	if got := acquireN(t, nm, source, 1)[0]; got != 1 {
+		t.Fatalf("after releasing the last nonce: got %d, want 1", got)
+	}
+}
+
+func TestNonceManagerReleaseMiddle(t *testing.T) {
+	nm := NewNonceManager()
+	pending := uint64(0)
+	source := fixedSource(&pending)
+
+	acquireN(t, nm, source, 3)
+	nm.Commit(nonceTestAddress, 0)
+	nm.Release(nonceTestAddress, 1, errors.New("insufficient funds"))
+
+	// Le trou est rebouché avant d'avancer ; 2 est toujours en vol
+	if got := acquireN(t, nm, source, 2); got[0] != 1 || got[1] != 3 {
+		t.Fatalf("after releasing nonce 1: got %v, want [1 3]", got)
+	}
+}
+
+func TestNonceManagerNonceErrorKeepsInFlight(t *testing.T) {
+	nm := NewNonceManager()
+	pending := uint64(0)
+	source := fixedSource(&pending)
+
+	acquireN(t, nm, source, 4)
+	nm.Commit(nonceTestAddress, 0)
+
+	// Le node ne connaît que la transaction 0 ; 2 et 3 sont encore en cours d'envoi
+	pending = 1
+	nm.Release(nonceTestAddress, 1, errors.New("nonce too high"))
+
+	got := acquireN(t, nm, source, 2)
+	for _, nonce := range got {
+		if nonce == 2 || nonce == 3 {
+			t.Fatalf("in-flight nonce %d handed out again (got %v)", nonce, got)
+		}
+	}
+	if got[0] != 1 || got[1] != 4 {
+		t.Fatalf("after the resync: got %v, want [1 4]", got)
+	}
+}
+
+func TestNonceManagerResyncDropsConsumedGap(t *testing.T) {
+	nm := NewNonceManager()
+	pending := uint64(0)
+	source := fixedSource(&pending)
+
+	acquireN(t, nm, source, 3)
+	nm.Commit(nonceTestAddress, 0)
+	nm.Commit(nonceTestAddress, 2)
+
+	// Le nonce 1 a été consommé par un envoi hors de benchy : ce n'est plus un trou
+	pending = 3
+	nm.Release(nonceTestAddress, 1, errors.New("nonce too low"))
+
+	if got := acquireN(t, nm, source, 1)[0]; got != 3 {
+		t.Fatalf("after the resync: got %d, want 3", got)
+	}
+}
+
+func TestNonceManagerConcurrentReleases(t *testing.T) {
+	nm := NewNonceManager()
+	pending := uint64(0)
+	source := fixedSource(&pending)
+
+	// Chaque nonce détenu ne doit être distribué qu'une fois tant qu'il n'a pas été rendu
+	var held sync.Map
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				nonce, err := nm.Acquire(context.Background(), nonceTestAddress, source)
+				if err != nil {
+					t.Errorf("Acquire: %v", err)
+					return
+				}
+				if _, loaded := held.LoadOrStore(nonce, true); loaded {
+					t.Errorf("nonce %d handed out while in flight", nonce)
+					return
+				}
+				if (i+j)%3 == 0 {
+					held.Delete(nonce)
+					nm.Release(nonceTestAddress, nonce, errors.New("rejected"))
+				} else {
+					nm.Commit(nonceTestAddress, nonce)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
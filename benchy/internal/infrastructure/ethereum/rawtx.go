@@ -0,0 +1,197 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"benchy/internal/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// unsignedLegacyTx est la charge signée d'une transaction legacy EIP-155 : chain ID à la place de V, R et S nuls
+type unsignedLegacyTx struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	To       *common.Address `rlp:"nil"`
+	Value    *big.Int
+	Data     []byte
+	ChainID  *big.Int
+	R        uint
+	S        uint
+}
+
+// unsignedDynamicFeeTx est la charge signée d'une transaction EIP-1559, préfixée par son type
+type unsignedDynamicFeeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         *common.Address `rlp:"nil"`
+	Value      *big.Int
+	Data       []byte
+	AccessList types.AccessList
+}
+
+// ChainID retourne le chain ID d'un node
+func (ec *EthereumClient) ChainID(ctx context.Context, nodeURL string) (*big.Int, error) {
+	return ec.getChainID(ctx, nodeURL)
+}
+
+// FillTransaction complète les frais EIP-1559 et la limite de gas manquants, comme avant un envoi,
+// sans toucher au nonce ni signer
+func (ec *EthereumClient) FillTransaction(ctx context.Context, nodeURL string, tx *entities.Transaction) error {
+	if err := ec.fillFees(ctx, nodeURL, tx); err != nil {
+		return err
+	}
+
+	if tx.Gas == 0 {
+		gas, err := ec.EstimateGas(ctx, nodeURL, tx)
+		if err != nil {
+			return err
+		}
+		tx.Gas = gas
+	}
+
+	return nil
+}
+
+// NewRawTransaction construit la transaction go-ethereum non signée d'une transaction benchy.
+// Une transaction de type contrat est un déploiement (pas de destinataire).
+func NewRawTransaction(tx *entities.Transaction, chainID *big.Int) *types.Transaction {
+	var to *common.Address
+	if tx.Type != entities.TxTypeContract {
+		address := tx.To
+		to = &address
+	}
+
+	return types.NewTx(buildTxData(tx, to, chainID))
+}
+
+// EncodeUnsignedTransaction encode une transaction non signée sous la forme que signe sa clé
+// (comme ethers) : son keccak est le hash de signature
+func EncodeUnsignedTransaction(tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return rlp.EncodeToBytes(&unsignedLegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: tx.GasPrice(),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+			ChainID:  chainID,
+		})
+
+	case types.DynamicFeeTxType:
+		payload, err := rlp.EncodeToBytes(&unsignedDynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{types.DynamicFeeTxType}, payload...), nil
+	}
+
+	return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+}
+
+// DecodeUnsignedTransaction décode une transaction encodée par EncodeUnsignedTransaction
+// et retourne son chain ID
+func DecodeUnsignedTransaction(data []byte) (*types.Transaction, *big.Int, error) {
+	if len(data) == 0 {
+		return nil, nil, fmt.Errorf("empty transaction")
+	}
+
+	// Une liste RLP commence à 0xc0 : transaction legacy ; sinon octet de type EIP-2718
+	if data[0] >= 0xc0 {
+		var unsigned unsignedLegacyTx
+		if err := rlp.Decode(bytes.NewReader(data), &unsigned); err != nil {
+			return nil, nil, fmt.Errorf("invalid unsigned legacy transaction: %w", err)
+		}
+		if unsigned.R != 0 || unsigned.S != 0 {
+			return nil, nil, fmt.Errorf("transaction is already signed")
+		}
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    unsigned.Nonce,
+			GasPrice: unsigned.GasPrice,
+			Gas:      unsigned.Gas,
+			To:       unsigned.To,
+			Value:    unsigned.Value,
+			Data:     unsigned.Data,
+		}), unsigned.ChainID, nil
+	}
+
+	if data[0] != types.DynamicFeeTxType {
+		return nil, nil, fmt.Errorf("unsupported transaction type %d", data[0])
+	}
+
+	var unsigned unsignedDynamicFeeTx
+	if err := rlp.Decode(bytes.NewReader(data[1:]), &unsigned); err != nil {
+		return nil, nil, fmt.Errorf("invalid unsigned EIP-1559 transaction (already signed?): %w", err)
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    unsigned.ChainID,
+		Nonce:      unsigned.Nonce,
+		GasTipCap:  unsigned.GasTipCap,
+		GasFeeCap:  unsigned.GasFeeCap,
+		Gas:        unsigned.Gas,
+		To:         unsigned.To,
+		Value:      unsigned.Value,
+		Data:       unsigned.Data,
+		AccessList: unsigned.AccessList,
+	}), unsigned.ChainID, nil
+}
+
+// SignRawTransaction signe une transaction pour un chain ID (EIP-155 pour les transactions legacy)
+func SignRawTransaction(tx *types.Transaction, chainID *big.Int, key *ecdsa.PrivateKey) (*types.Transaction, error) {
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	return signedTx, nil
+}
+
+// DecodeSignedTransaction décode une transaction signée (format de eth_sendRawTransaction)
+// et retrouve son émetteur
+func DecodeSignedTransaction(data []byte) (*types.Transaction, common.Address, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return nil, common.Address{}, fmt.Errorf("invalid signed transaction: %w", err)
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("invalid transaction signature: %w", err)
+	}
+
+	return tx, from, nil
+}
+
+// SendRawTransaction diffuse une transaction déjà signée sur un node (eth_sendRawTransaction)
+func (ec *EthereumClient) SendRawTransaction(ctx context.Context, nodeURL string, tx *types.Transaction) (common.Hash, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send raw transaction: %w", err)
+	}
+
+	return tx.Hash(), nil
+}
@@ -0,0 +1,166 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// MinReplacementBumpPercent est l'augmentation minimale des frais exigée par les txpools
+// de Geth (--txpool.pricebump) et de Nethermind pour accepter un remplacement
+const MinReplacementBumpPercent = 10
+
+var (
+	// ErrAlreadyMined est retournée quand la transaction à remplacer est déjà incluse dans un bloc
+	ErrAlreadyMined = errors.New("transaction already mined")
+	// ErrReplacementUnderpriced est retournée quand le node refuse le remplacement pour frais insuffisants
+	ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+)
+
+// ReplaceTransaction renvoie le nonce d'une transaction en attente avec des frais augmentés,
+// soit avec le même contenu (speed-up), soit en 0 ETH vers soi-même (cancel)
+func (ec *EthereumClient) ReplaceTransaction(ctx context.Context, nodeURL string, original *entities.Transaction, mode entities.ReplacementMode) (*entities.Transaction, error) {
+	if mode != entities.ReplaceSpeedUp && mode != entities.ReplaceCancel {
+		return nil, fmt.Errorf("unknown replacement mode: %s", mode)
+	}
+
+	if _, err := ec.GetTransactionReceipt(ctx, nodeURL, original.Hash); err == nil {
+		return nil, fmt.Errorf("cannot replace %s: %w", original.Hash.Hex(), ErrAlreadyMined)
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+
+	// Les frais et le nonce d'origine sont lus depuis le node si la transaction n'a pas été envoyée par ce client
+	pending := original.EthTx
+	if pending == nil {
+		client, err := ec.getClient(ctx, nodeURL)
+		if err != nil {
+			return nil, err
+		}
+		pending, _, err = client.TransactionByHash(ctx, original.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transaction %s: %w", original.Hash.Hex(), err)
+		}
+	}
+
+	suggested, err := ec.SuggestFees(ctx, nodeURL, ec.FeePolicy())
+	if err != nil {
+		return nil, err
+	}
+	replacement, to := newReplacement(original, pending, mode, suggested)
+
+	if _, err := ec.sendWithNonce(ctx, nodeURL, replacement, to); err != nil {
+		if isUnderpricedError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrReplacementUnderpriced, err)
+		}
+		return nil, err
+	}
+
+	return replacement, nil
+}
+
+// newReplacement construit le remplaçant d'une transaction en attente (même nonce), avec des frais
+// augmentés, et retourne son destinataire (nil pour un déploiement)
+func newReplacement(original *entities.Transaction, pending *types.Transaction, mode entities.ReplacementMode, suggested *ports.FeeSuggestion) (*entities.Transaction, *common.Address) {
+	replacement := &entities.Transaction{
+		Type:      entities.TxTypeReplacement,
+		Status:    entities.TxStatusPending,
+		From:      original.From,
+		Nonce:     pending.Nonce(),
+		Replaces:  original.Hash,
+		CreatedAt: time.Now(),
+	}
+
+	var to *common.Address
+	switch mode {
+	case entities.ReplaceSpeedUp:
+		replacement.To = original.To
+		replacement.Value = pending.Value()
+		replacement.Data = pending.Data()
+		replacement.Gas = pending.Gas()
+		to = pending.To()
+	case entities.ReplaceCancel:
+		replacement.To = original.From
+		replacement.Value = big.NewInt(0)
+		replacement.Gas = params.TxGas
+		to = &replacement.To
+	}
+
+	bumpFees(pending, replacement, suggested)
+
+	return replacement, to
+}
+
+// bumpFees fixe les frais du remplaçant : au moins +MinReplacementBumpPercent sur chaque composante,
+// et jamais moins que les frais actuellement suggérés
+func bumpFees(pending *types.Transaction, replacement *entities.Transaction, suggested *ports.FeeSuggestion) {
+	if pending.Type() == types.LegacyTxType {
+		replacement.GasPrice = maxBig(bumped(pending.GasPrice()), suggested.GasFeeCap)
+		return
+	}
+
+	replacement.GasTipCap = maxBig(bumped(pending.GasTipCap()), suggested.GasTipCap)
+	replacement.GasFeeCap = maxBig(bumped(pending.GasFeeCap()), suggested.GasFeeCap)
+	if replacement.GasFeeCap.Cmp(replacement.GasTipCap) < 0 {
+		replacement.GasFeeCap = new(big.Int).Set(replacement.GasTipCap)
+	}
+}
+
+// sendWithNonce signe et diffuse une transaction dont le nonce est déjà fixé (hors NonceManager)
+func (ec *EthereumClient) sendWithNonce(ctx context.Context, nodeURL string, tx *entities.Transaction, to *common.Address) (common.Hash, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	signedTx, err := ec.signTx(ctx, nodeURL, tx, to, tx.Nonce)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := client.SendTransaction(ctx, signedTx); err != nil && !isAlreadyKnownError(err) {
+		return common.Hash{}, fmt.Errorf("failed to send raw transaction: %w", err)
+	}
+
+	tx.EthTx = signedTx
+	tx.Hash = signedTx.Hash()
+
+	return tx.Hash, nil
+}
+
+// bumped retourne value augmenté du bump minimal, arrondi au wei supérieur
+func bumped(value *big.Int) *big.Int {
+	result := new(big.Int).Mul(value, big.NewInt(100+MinReplacementBumpPercent))
+	result.Div(result, big.NewInt(100))
+	return result.Add(result, big.NewInt(1))
+}
+
+// maxBig retourne le plus grand des deux montants
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return new(big.Int).Set(a)
+	}
+	return new(big.Int).Set(b)
+}
+
+// isUnderpricedError détecte un refus de remplacement (Geth : "replacement transaction underpriced",
+// Nethermind : "FeeTooLowToCompete" / "ReplacementNotAllowed")
+func isUnderpricedError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, pattern := range []string{"underpriced", "feetoolowtocompete", "replacementnotallowed"} {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+	return false
+}
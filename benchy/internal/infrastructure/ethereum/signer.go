@@ -0,0 +1,163 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"benchy/internal/domain/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// AddAccount enregistre une clé privée utilisée pour signer localement
+func (ec *EthereumClient) AddAccount(privateKey *ecdsa.PrivateKey) common.Address {
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+	ec.keys[address] = privateKey
+
+	return address
+}
+
+// getKey retourne la clé privée associée à une adresse
+func (ec *EthereumClient) getKey(address common.Address) (*ecdsa.PrivateKey, error) {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+
+	key, exists := ec.keys[address]
+	if !exists {
+		return nil, fmt.Errorf("no private key registered for %s", address.Hex())
+	}
+
+	return key, nil
+}
+
+// getChainID retourne le chain ID du node (mis en cache par URL)
+func (ec *EthereumClient) getChainID(ctx context.Context, nodeURL string) (*big.Int, error) {
+	ec.mutex.RLock()
+	chainID, exists := ec.chainIDs[nodeURL]
+	ec.mutex.RUnlock()
+	if exists {
+		return chainID, nil
+	}
+
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err = client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	ec.mutex.Lock()
+	ec.chainIDs[nodeURL] = chainID
+	ec.mutex.Unlock()
+
+	return chainID, nil
+}
+
+// signAndSend complète, signe localement et diffuse une transaction (eth_sendRawTransaction)
+func (ec *EthereumClient) signAndSend(ctx context.Context, nodeURL string, tx *entities.Transaction, to *common.Address) (common.Hash, error) {
+	client, err := ec.getClient(ctx, nodeURL)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := ec.fillFees(ctx, nodeURL, tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	if tx.Gas == 0 {
+		gas, err := ec.estimateGas(ctx, nodeURL, tx, to)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		tx.Gas = gas
+	}
+
+	source := func(ctx context.Context) (uint64, error) {
+		return ec.GetNonce(ctx, nodeURL, tx.From)
+	}
+
+	// Une seconde tentative après resynchronisation si le node refuse le nonce
+	for attempt := 0; ; attempt++ {
+		nonce, err := ec.nonces.Acquire(ctx, tx.From, source)
+		if err != nil {
+			return common.Hash{}, err
+		}
+
+		signedTx, err := ec.signTx(ctx, nodeURL, tx, to, nonce)
+		if err != nil {
+			ec.nonces.Release(tx.From, nonce, err)
+			return common.Hash{}, err
+		}
+
+		// Déjà connue : le node a accepté exactement cette transaction, dont le hash est déjà calculé
+		if err := client.SendTransaction(ctx, signedTx); err != nil && !isAlreadyKnownError(err) {
+			ec.nonces.Release(tx.From, nonce, err)
+			if attempt == 0 && IsNonceError(err) {
+				continue
+			}
+			return common.Hash{}, fmt.Errorf("failed to send raw transaction: %w", err)
+		}
+
+		ec.nonces.Commit(tx.From, nonce)
+
+		tx.EthTx = signedTx
+		tx.Hash = signedTx.Hash()
+
+		return tx.Hash, nil
+	}
+}
+
+// signTx fixe le nonce de la transaction et la signe avec la clé de l'expéditeur, pour le chain ID du node
+func (ec *EthereumClient) signTx(ctx context.Context, nodeURL string, tx *entities.Transaction, to *common.Address, nonce uint64) (*types.Transaction, error) {
+	key, err := ec.getKey(tx.From)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := ec.getChainID(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Nonce = nonce
+	return SignRawTransaction(types.NewTx(buildTxData(tx, to, chainID)), chainID, key)
+}
+
+// buildTxData construit la transaction go-ethereum (legacy EIP-155 ou dynamic-fee EIP-1559)
+func buildTxData(tx *entities.Transaction, to *common.Address, chainID *big.Int) types.TxData {
+	value := tx.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	if tx.IsDynamicFee() {
+		return &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     tx.Nonce,
+			GasTipCap: tx.GasTipCap,
+			GasFeeCap: tx.GasFeeCap,
+			Gas:       tx.Gas,
+			To:        to,
+			Value:     value,
+			Data:      tx.Data,
+		}
+	}
+
+	return &types.LegacyTx{
+		Nonce:    tx.Nonce,
+		GasPrice: tx.GasPrice,
+		Gas:      tx.Gas,
+		To:       to,
+		Value:    value,
+		Data:     tx.Data,
+	}
+}
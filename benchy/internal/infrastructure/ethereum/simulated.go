@@ -0,0 +1,948 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// errSimulatedUnsupported est retournée par les opérations sans équivalent sur une chaîne en mémoire
+	errSimulatedUnsupported = errors.New("not supported by the simulated backend")
+	// errSimulatedClosed termine les abonnements quand le backend simulé est arrêté
+	errSimulatedClosed = errors.New("simulated backend closed")
+)
+
+// SimulatedClient implémente l'interface EthereumService sur une chaîne en mémoire (simulated backend
+// de go-ethereum) : blocs, reçus, balances, contrats, logs et nonces comme sur le réseau, sans node.
+// Toutes les URLs de node désignent la même chaîne, dont l'horloge est celle du backend simulé : le genesis
+// est daté de 0 et chaque bloc a 10 s de plus que son parent. Le mempool est tenu par le client : les transactions
+// attendent le prochain bloc, peuvent être remplacées (même nonce, frais augmentés) et restent en queued
+// derrière un trou de nonce.
+type SimulatedClient struct {
+	backend   *backends.SimulatedBackend
+	chainID   *big.Int
+	signers   []common.Address // Validateurs Clique du genesis, triés
+	period    time.Duration    // 0 : un bloc par transaction
+	keys      map[common.Address]*ecdsa.PrivateKey
+	pool      map[common.Address]map[uint64]*types.Transaction // expéditeur → nonce → transaction
+	events    *EventDecoder
+	feePolicy entities.FeePolicy
+	heads     event.Feed
+	pending   event.Feed
+	stop      chan struct{}
+	closeOnce sync.Once
+	mutex     sync.RWMutex
+}
+
+var _ ports.EthereumService = (*SimulatedClient)(nil)
+
+// NewSimulatedClient crée une chaîne en mémoire à partir des allocations, de la limite de gas et des
+// validateurs Clique d'un genesis benchy. Avec une période, un bloc est scellé à intervalle régulier
+// comme sur le réseau Clique ; sans période, chaque transaction est minée dès son envoi.
+func NewSimulatedClient(genesis *core.Genesis, period time.Duration) (*SimulatedClient, error) {
+	chainID := params.AllEthashProtocolChanges.ChainID
+	if genesis.Config != nil && genesis.Config.ChainID != nil && genesis.Config.ChainID.Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("the simulated backend only supports chain ID %s (genesis: %s)", chainID, genesis.Config.ChainID)
+	}
+
+	signers, err := genesisSigners(genesis.ExtraData)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &SimulatedClient{
+		backend:   backends.NewSimulatedBackend(genesis.Alloc, genesis.GasLimit),
+		chainID:   chainID,
+		signers:   signers,
+		period:    period,
+		keys:      make(map[common.Address]*ecdsa.PrivateKey),
+		pool:      make(map[common.Address]map[uint64]*types.Transaction),
+		events:    NewEventDecoder(),
+		feePolicy: entities.FeePolicyNormal,
+		stop:      make(chan struct{}),
+	}
+
+	if period > 0 {
+		go sc.sealLoop()
+	}
+
+	return sc, nil
+}
+
+// Close arrête le scellement des blocs, les abonnements et la chaîne en mémoire
+func (sc *SimulatedClient) Close() {
+	sc.closeOnce.Do(func() {
+		close(sc.stop)
+		sc.backend.Close()
+	})
+}
+
+// Mine scelle un bloc avec les transactions exécutables du mempool, dans l'ordre des nonces.
+// Une transaction que le bloc ne peut pas inclure (fee cap sous le base fee, fonds ou gas insuffisants)
+// reste en attente avec celles qui la suivent.
+func (sc *SimulatedClient) Mine() *ports.BlockHeader {
+	sc.mutex.Lock()
+
+	ctx := context.Background()
+	parent := sc.backend.Blockchain().CurrentBlock()
+	baseFee, _ := sc.backend.SuggestGasPrice(ctx)
+	gasLeft := parent.GasLimit()
+
+	senders := make([]common.Address, 0, len(sc.pool))
+	for sender := range sc.pool {
+		senders = append(senders, sender)
+	}
+	sortAddresses(senders)
+
+	for _, sender := range senders {
+		nonce, _ := sc.backend.NonceAt(ctx, sender, nil)
+		balance, _ := sc.backend.BalanceAt(ctx, sender, nil)
+		balance = new(big.Int).Set(balance)
+
+		for tx, exists := sc.pool[sender][nonce]; exists; tx, exists = sc.pool[sender][nonce] {
+			// Vérifications faites avant l'exécution : le backend panique sur une transaction invalide
+			cost := new(big.Int).Add(new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas())), tx.Value())
+			if tx.GasFeeCap().Cmp(baseFee) < 0 || tx.Gas() > gasLeft || balance.Cmp(cost) < 0 {
+				break
+			}
+			if err := sc.submit(ctx, tx); err != nil {
+				break
+			}
+
+			delete(sc.pool[sender], nonce)
+			gasLeft -= tx.Gas()
+			balance.Sub(balance, cost)
+			nonce++
+		}
+	}
+
+	sc.backend.Commit()
+	head := sc.backend.Blockchain().CurrentBlock().Header()
+
+	// Les transactions dont le nonce est maintenant consommé ne seront jamais minées
+	for sender, byNonce := range sc.pool {
+		nonce, _ := sc.backend.NonceAt(ctx, sender, nil)
+		for txNonce := range byNonce {
+			if txNonce < nonce {
+				delete(byNonce, txNonce)
+			}
+		}
+		if len(byNonce) == 0 {
+			delete(sc.pool, sender)
+		}
+	}
+
+	sc.mutex.Unlock()
+
+	header := toBlockHeader(head)
+	sc.heads.Send(header)
+
+	return header
+}
+
+// submit ajoute une transaction au bloc en construction, en convertissant une panique du backend en erreur
+func (sc *SimulatedClient) submit(ctx context.Context, tx *types.Transaction) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("failed to include %s: %v", tx.Hash().Hex(), recovered)
+		}
+	}()
+
+	return sc.backend.SendTransaction(ctx, tx)
+}
+
+// sealLoop scelle un bloc à chaque période, même vide, comme un validateur Clique
+func (sc *SimulatedClient) sealLoop() {
+	ticker := time.NewTicker(sc.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sc.Mine()
+		case <-sc.stop:
+			return
+		}
+	}
+}
+
+// ConnectToNode accepte toute URL : tous les nodes partagent la chaîne en mémoire
+func (sc *SimulatedClient) ConnectToNode(ctx context.Context, nodeURL string) error {
+	return nil
+}
+
+// DisconnectFromNode n'a rien à fermer
+func (sc *SimulatedClient) DisconnectFromNode(ctx context.Context, nodeURL string) error {
+	return nil
+}
+
+// IsNodeConnected retourne true tant que la chaîne n'est pas arrêtée
+func (sc *SimulatedClient) IsNodeConnected(ctx context.Context, nodeURL string) (bool, error) {
+	select {
+	case <-sc.stop:
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// GetLatestBlockNumber retourne le numéro du dernier bloc scellé
+func (sc *SimulatedClient) GetLatestBlockNumber(ctx context.Context, nodeURL string) (uint64, error) {
+	return sc.backend.Blockchain().CurrentBlock().NumberU64(), nil
+}
+
+// GetBlockByNumber récupère un bloc ; son signer est le validateur Clique en tour (numéro modulo signers)
+func (sc *SimulatedClient) GetBlockByNumber(ctx context.Context, nodeURL string, blockNumber uint64) (*ports.BlockInfo, error) {
+	block, err := sc.backend.BlockByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", blockNumber, err)
+	}
+
+	signer := types.LatestSignerForChainID(sc.chainID)
+	txHashes := make([]common.Hash, len(block.Transactions()))
+	txDetails := make([]ports.TransactionInfo, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recover sender of %s: %w", tx.Hash().Hex(), err)
+		}
+
+		number := block.NumberU64()
+		txHashes[i] = tx.Hash()
+		txDetails[i] = toTransactionInfo(tx, from)
+		txDetails[i].BlockNumber = &number
+		txDetails[i].BlockHash = block.Hash()
+		txDetails[i].TransactionIndex = uint(i)
+	}
+
+	info := &ports.BlockInfo{
+		Number:       block.NumberU64(),
+		Hash:         block.Hash(),
+		ParentHash:   block.ParentHash(),
+		Timestamp:    block.Time(),
+		Difficulty:   block.Difficulty(),
+		GasLimit:     block.GasLimit(),
+		GasUsed:      block.GasUsed(),
+		Transactions: txHashes,
+		Miner:        block.Coinbase(),
+		BaseFee:      block.BaseFee(),
+		ExtraData:    block.Extra(),
+		TxDetails:    txDetails,
+	}
+	if block.NumberU64() > 0 {
+		info.Signer = sc.inTurnSigner(block.NumberU64())
+	}
+
+	return info, nil
+}
+
+// GetHeaderByNumber récupère l'en-tête d'un bloc ; nil : le dernier bloc scellé
+func (sc *SimulatedClient) GetHeaderByNumber(ctx context.Context, nodeURL string, blockNumber *uint64) (*ports.BlockHeader, error) {
+	var number *big.Int
+	if blockNumber != nil {
+		number = new(big.Int).SetUint64(*blockNumber)
+	}
+
+	header, err := sc.backend.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block header: %w", err)
+	}
+
+	return toBlockHeader(header), nil
+}
+
+// GetTransaction récupère une transaction du mempool ou de la chaîne
+func (sc *SimulatedClient) GetTransaction(ctx context.Context, nodeURL string, txHash common.Hash) (*ports.TransactionInfo, error) {
+	if tx, from, ok := sc.poolTransaction(txHash); ok {
+		info := toTransactionInfo(tx, from)
+		return &info, nil
+	}
+
+	tx, _, err := sc.backend.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(sc.chainID), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover sender of %s: %w", txHash.Hex(), err)
+	}
+
+	info := toTransactionInfo(tx, from)
+	if receipt, err := sc.backend.TransactionReceipt(ctx, txHash); err == nil {
+		number := receipt.BlockNumber.Uint64()
+		info.BlockNumber = &number
+		info.BlockHash = receipt.BlockHash
+		info.TransactionIndex = receipt.TransactionIndex
+	}
+
+	return &info, nil
+}
+
+// GetPeerCount retourne 0 : la chaîne en mémoire n'a pas de réseau P2P
+func (sc *SimulatedClient) GetPeerCount(ctx context.Context, nodeURL string) (int, error) {
+	return 0, nil
+}
+
+// GetPendingTransactionCount retourne le nombre de transactions exécutables du mempool
+func (sc *SimulatedClient) GetPendingTransactionCount(ctx context.Context, nodeURL string) (int, error) {
+	pending, _ := sc.poolContent(ctx)
+	count := 0
+	for _, txs := range pending {
+		count += len(txs)
+	}
+	return count, nil
+}
+
+// GetNodeSnapshot rassemble les métriques affichées par infos
+func (sc *SimulatedClient) GetNodeSnapshot(ctx context.Context, nodeURL string, address common.Address, tokens map[string]common.Address) (*ports.NodeSnapshot, error) {
+	latest, _ := sc.GetLatestBlockNumber(ctx, nodeURL)
+	peers, _ := sc.GetPeerCount(ctx, nodeURL)
+	pending, _ := sc.GetPendingTransactionCount(ctx, nodeURL)
+
+	snapshot := &ports.NodeSnapshot{
+		LatestBlock:   latest,
+		PeerCount:     &peers,
+		PendingTxs:    &pending,
+		TokenBalances: make(map[string]*big.Int),
+	}
+
+	if address != (common.Address{}) {
+		if balance, err := sc.GetBalance(ctx, nodeURL, address); err == nil {
+			snapshot.Balance = balance
+		}
+		for symbol, token := range tokens {
+			if balance, err := sc.GetTokenBalance(ctx, nodeURL, token, address); err == nil {
+				snapshot.TokenBalances[symbol] = balance
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// GetTxPoolStatus retourne le nombre de transactions pending et queued du mempool
+func (sc *SimulatedClient) GetTxPoolStatus(ctx context.Context, nodeURL string) (*ports.TxPoolStatus, error) {
+	pending, queued := sc.poolContent(ctx)
+
+	status := &ports.TxPoolStatus{}
+	for _, txs := range pending {
+		status.Pending += len(txs)
+	}
+	for _, txs := range queued {
+		status.Queued += len(txs)
+	}
+
+	return status, nil
+}
+
+// GetTxPoolContent retourne les transactions du mempool par expéditeur
+func (sc *SimulatedClient) GetTxPoolContent(ctx context.Context, nodeURL string) (*ports.TxPoolContent, error) {
+	pending, queued := sc.poolContent(ctx)
+	return &ports.TxPoolContent{Pending: pending, Queued: queued}, nil
+}
+
+// GetTxPoolInspect résume les transactions du mempool au format de txpool_inspect chez Geth
+func (sc *SimulatedClient) GetTxPoolInspect(ctx context.Context, nodeURL string) (*ports.TxPoolInspect, error) {
+	pending, queued := sc.poolContent(ctx)
+	return &ports.TxPoolInspect{
+		Pending: summarizePoolTransactions(pending),
+		Queued:  summarizePoolTransactions(queued),
+	}, nil
+}
+
+// GetBalance récupère la balance d'une adresse au dernier bloc
+func (sc *SimulatedClient) GetBalance(ctx context.Context, nodeURL string, address common.Address) (*big.Int, error) {
+	balance, err := sc.backend.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+	return balance, nil
+}
+
+// GetNonce retourne le nonce pending d'une adresse : nonce du compte suivi des transactions contiguës du mempool
+func (sc *SimulatedClient) GetNonce(ctx context.Context, nodeURL string, address common.Address) (uint64, error) {
+	sc.mutex.RLock()
+	defer sc.mutex.RUnlock()
+
+	return sc.pendingNonce(ctx, address)
+}
+
+// GetMinedNonce retourne le nonce d'une adresse au dernier bloc, sans les transactions du mempool
+func (sc *SimulatedClient) GetMinedNonce(ctx context.Context, nodeURL string, address common.Address) (uint64, error) {
+	nonce, err := sc.backend.NonceAt(ctx, address, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get mined nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// GetCode récupère le bytecode déployé à une adresse
+func (sc *SimulatedClient) GetCode(ctx context.Context, nodeURL string, address common.Address) ([]byte, error) {
+	code, err := sc.backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code: %w", err)
+	}
+	return code, nil
+}
+
+// AddAccount enregistre une clé privée utilisée pour signer localement
+func (sc *SimulatedClient) AddAccount(privateKey *ecdsa.PrivateKey) common.Address {
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+	sc.keys[address] = privateKey
+
+	return address
+}
+
+// SetFeePolicy définit la politique de frais des transactions qui n'en précisent pas
+func (sc *SimulatedClient) SetFeePolicy(policy entities.FeePolicy) {
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+
+	sc.feePolicy = policy
+}
+
+// FeePolicy retourne la politique de frais par défaut du client
+func (sc *SimulatedClient) FeePolicy() entities.FeePolicy {
+	sc.mutex.RLock()
+	defer sc.mutex.RUnlock()
+
+	return sc.feePolicy
+}
+
+// RegisterABI enregistre une ABI dont les événements seront décodés dans les reçus
+func (sc *SimulatedClient) RegisterABI(contractABI abi.ABI) {
+	sc.events.RegisterABI(contractABI)
+}
+
+// SendTransaction signe la transaction avec la clé de l'expéditeur et la place dans le mempool
+func (sc *SimulatedClient) SendTransaction(ctx context.Context, nodeURL string, tx *entities.Transaction) (common.Hash, error) {
+	to := tx.To
+	return sc.signAndSend(ctx, tx, &to, false)
+}
+
+// GetTransactionStatus récupère le statut d'une transaction
+func (sc *SimulatedClient) GetTransactionStatus(ctx context.Context, nodeURL string, txHash common.Hash) (entities.TransactionStatus, error) {
+	if _, _, ok := sc.poolTransaction(txHash); ok {
+		return entities.TxStatusPending, nil
+	}
+
+	receipt, err := sc.backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return entities.TxStatusPending, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return entities.TxStatusConfirmed, nil
+	}
+
+	return entities.TxStatusFailed, nil
+}
+
+// GetTransactionReceipt récupère le reçu d'une transaction minée, avec ses événements décodés
+func (sc *SimulatedClient) GetTransactionReceipt(ctx context.Context, nodeURL string, txHash common.Hash) (*ports.TransactionReceipt, error) {
+	receipt, err := sc.backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, _, err := sc.backend.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(sc.chainID), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover sender of %s: %w", txHash.Hex(), err)
+	}
+
+	result := toPortReceipt(receipt, from, tx.To())
+	sc.events.DecodeReceipt(result)
+
+	return result, nil
+}
+
+// ReplaceTransaction remplace une transaction du mempool (même nonce, frais augmentés)
+func (sc *SimulatedClient) ReplaceTransaction(ctx context.Context, nodeURL string, original *entities.Transaction, mode entities.ReplacementMode) (*entities.Transaction, error) {
+	if mode != entities.ReplaceSpeedUp && mode != entities.ReplaceCancel {
+		return nil, fmt.Errorf("unknown replacement mode: %s", mode)
+	}
+
+	if _, err := sc.backend.TransactionReceipt(ctx, original.Hash); err == nil {
+		return nil, fmt.Errorf("cannot replace %s: %w", original.Hash.Hex(), ErrAlreadyMined)
+	}
+
+	pending := original.EthTx
+	if pending == nil {
+		tx, _, ok := sc.poolTransaction(original.Hash)
+		if !ok {
+			return nil, fmt.Errorf("failed to get transaction %s: %w", original.Hash.Hex(), ethereum.NotFound)
+		}
+		pending = tx
+	}
+
+	suggested, err := sc.SuggestFees(ctx, nodeURL, sc.FeePolicy())
+	if err != nil {
+		return nil, err
+	}
+	replacement, to := newReplacement(original, pending, mode, suggested)
+
+	if _, err := sc.signAndSend(ctx, replacement, to, true); err != nil {
+		return nil, err
+	}
+
+	return replacement, nil
+}
+
+// EstimateGas estime la limite de gas d'une transaction sur l'état en attente, avec marge pour les contrats
+func (sc *SimulatedClient) EstimateGas(ctx context.Context, nodeURL string, tx *entities.Transaction) (uint64, error) {
+	var to *common.Address
+	if tx.Type != entities.TxTypeContract {
+		address := tx.To
+		to = &address
+	}
+
+	return sc.estimateGas(ctx, tx, to)
+}
+
+// SuggestGasTipCap retourne le tip plancher des validateurs Geth
+func (sc *SimulatedClient) SuggestGasTipCap(ctx context.Context, nodeURL string) (*big.Int, error) {
+	return big.NewInt(minGasTipCap), nil
+}
+
+// SuggestFees calcule tip et fee cap selon la politique, à partir du base fee du dernier bloc
+func (sc *SimulatedClient) SuggestFees(ctx context.Context, nodeURL string, policy entities.FeePolicy) (*ports.FeeSuggestion, error) {
+	tip, err := sc.SuggestGasTipCap(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	head := sc.backend.Blockchain().CurrentBlock().Header()
+	return newFeeSuggestion(tip, head.BaseFee, policy), nil
+}
+
+// DeployContract déploie un smart contract signé localement par from
+func (sc *SimulatedClient) DeployContract(ctx context.Context, nodeURL string, contractCode []byte, from common.Address) (common.Address, common.Hash, error) {
+	tx := entities.NewTransaction(from, common.Address{}, big.NewInt(0), entities.TxTypeContract)
+	tx.Data = contractCode
+
+	hash, err := sc.signAndSend(ctx, tx, nil, false)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to deploy contract: %w", err)
+	}
+
+	return crypto.CreateAddress(from, tx.Nonce), hash, nil
+}
+
+// CallContract appelle une méthode en lecture seule d'un smart contract au dernier bloc
+func (sc *SimulatedClient) CallContract(ctx context.Context, nodeURL string, contractAddress common.Address, data []byte) ([]byte, error) {
+	result, err := sc.backend.CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+	return result, nil
+}
+
+// GetTokenBalance récupère la balance d'un token ERC20 (balanceOf)
+func (sc *SimulatedClient) GetTokenBalance(ctx context.Context, nodeURL string, tokenAddress, holderAddress common.Address) (*big.Int, error) {
+	data, err := contracts.PackBalanceOf(holderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := sc.CallContract(ctx, nodeURL, tokenAddress, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token balance: %w", err)
+	}
+
+	return contracts.UnpackUint256("balanceOf", result)
+}
+
+// TransferToken transfère des tokens ERC20 (transfer)
+func (sc *SimulatedClient) TransferToken(ctx context.Context, nodeURL string, tokenAddress, from, to common.Address, amount *big.Int) (common.Hash, error) {
+	data, err := contracts.PackTransfer(to, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx := entities.NewTransaction(from, tokenAddress, big.NewInt(0), entities.TxTypeERC20)
+	tx.Data = data
+
+	hash, err := sc.signAndSend(ctx, tx, &tokenAddress, false)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to transfer token: %w", err)
+	}
+
+	return hash, nil
+}
+
+// GetSigners retourne les validateurs Clique du genesis (l'ensemble ne change pas sur la chaîne simulée)
+func (sc *SimulatedClient) GetSigners(ctx context.Context, nodeURL string) ([]common.Address, error) {
+	return append([]common.Address(nil), sc.signers...), nil
+}
+
+// GetSnapshot retourne l'état Clique au dernier bloc : validateurs du genesis et derniers signers en tour
+func (sc *SimulatedClient) GetSnapshot(ctx context.Context, nodeURL string) (*ports.CliqueSnapshot, error) {
+	head := sc.backend.Blockchain().CurrentBlock()
+
+	snapshot := &ports.CliqueSnapshot{
+		Number:  head.NumberU64(),
+		Hash:    head.Hash(),
+		Signers: append([]common.Address(nil), sc.signers...),
+		Recents: make(map[uint64]common.Address),
+		Votes:   []ports.CliqueVote{},
+		Tally:   make(map[common.Address]ports.CliqueTally),
+	}
+
+	// Clique interdit à un signer de sceller plus d'un bloc sur len(signers)/2 + 1
+	limit := uint64(len(sc.signers)/2 + 1)
+	for number := head.NumberU64(); number > 0 && head.NumberU64()-number < limit; number-- {
+		snapshot.Recents[number] = sc.inTurnSigner(number)
+	}
+
+	return snapshot, nil
+}
+
+// Propose n'est pas disponible : la chaîne simulée n'exécute pas le vote Clique
+func (sc *SimulatedClient) Propose(ctx context.Context, nodeURL string, address common.Address, authorize bool) error {
+	return fmt.Errorf("clique_propose: %w", errSimulatedUnsupported)
+}
+
+// Discard n'est pas disponible : la chaîne simulée n'exécute pas le vote Clique
+func (sc *SimulatedClient) Discard(ctx context.Context, nodeURL string, address common.Address) error {
+	return fmt.Errorf("clique_discard: %w", errSimulatedUnsupported)
+}
+
+// GetNodeInfo n'est pas disponible : la chaîne simulée n'a pas d'identité P2P
+func (sc *SimulatedClient) GetNodeInfo(ctx context.Context, nodeURL string) (*ports.NodeP2PInfo, error) {
+	return nil, fmt.Errorf("admin_nodeInfo: %w", errSimulatedUnsupported)
+}
+
+// AddPeer n'est pas disponible : la chaîne simulée n'a pas de réseau P2P
+func (sc *SimulatedClient) AddPeer(ctx context.Context, nodeURL string, enode string) error {
+	return fmt.Errorf("admin_addPeer: %w", errSimulatedUnsupported)
+}
+
+// SubscribeNewHeads reçoit chaque bloc scellé
+func (sc *SimulatedClient) SubscribeNewHeads(ctx context.Context, wsURL string, heads chan<- *ports.BlockHeader) (ports.Subscription, error) {
+	headers := make(chan *ports.BlockHeader, 16)
+	feedSub := sc.heads.Subscribe(headers)
+	sub, subCtx := newSimulatedSubscription(ctx)
+
+	go func() {
+		defer feedSub.Unsubscribe()
+
+		for {
+			select {
+			case header := <-headers:
+				select {
+				case heads <- header:
+				case <-subCtx.Done():
+					sub.stop(nil)
+					return
+				}
+			case <-subCtx.Done():
+				sub.stop(nil)
+				return
+			case <-sc.stop:
+				sub.stop(errSimulatedClosed)
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// SubscribePendingTransactions reçoit le hash de chaque transaction acceptée dans le mempool
+func (sc *SimulatedClient) SubscribePendingTransactions(ctx context.Context, wsURL string, hashes chan<- common.Hash) (ports.Subscription, error) {
+	pending := make(chan common.Hash, 256)
+	feedSub := sc.pending.Subscribe(pending)
+	sub, subCtx := newSimulatedSubscription(ctx)
+
+	go func() {
+		defer feedSub.Unsubscribe()
+
+		for {
+			select {
+			case hash := <-pending:
+				select {
+				case hashes <- hash:
+				case <-subCtx.Done():
+					sub.stop(nil)
+					return
+				}
+			case <-subCtx.Done():
+				sub.stop(nil)
+				return
+			case <-sc.stop:
+				sub.stop(errSimulatedClosed)
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// newSimulatedSubscription crée un abonnement annulé par Unsubscribe ou par le contexte de l'appelant
+func newSimulatedSubscription(ctx context.Context) (*wsSubscription, context.Context) {
+	subCtx, cancel := context.WithCancel(ctx)
+	return &wsSubscription{
+		cancel: cancel,
+		errCh:  make(chan error, 1),
+	}, subCtx
+}
+
+// signAndSend complète et signe une transaction puis la soumet au mempool. Sans keepNonce, elle prend
+// le nonce pending de l'expéditeur.
+func (sc *SimulatedClient) signAndSend(ctx context.Context, tx *entities.Transaction, to *common.Address, keepNonce bool) (common.Hash, error) {
+	sc.mutex.RLock()
+	key, exists := sc.keys[tx.From]
+	sc.mutex.RUnlock()
+	if !exists {
+		return common.Hash{}, fmt.Errorf("no private key registered for %s", tx.From.Hex())
+	}
+
+	err := completeFees(tx, sc.FeePolicy(), func(policy entities.FeePolicy) (*ports.FeeSuggestion, error) {
+		return sc.SuggestFees(ctx, "", policy)
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if tx.Gas == 0 {
+		gas, err := sc.estimateGas(ctx, tx, to)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		tx.Gas = gas
+	}
+
+	sc.mutex.Lock()
+	if !keepNonce {
+		if tx.Nonce, err = sc.pendingNonce(ctx, tx.From); err != nil {
+			sc.mutex.Unlock()
+			return common.Hash{}, err
+		}
+	}
+
+	signedTx, err := SignRawTransaction(types.NewTx(buildTxData(tx, to, sc.chainID)), sc.chainID, key)
+	if err != nil {
+		sc.mutex.Unlock()
+		return common.Hash{}, err
+	}
+
+	err = sc.addToPool(ctx, signedTx, tx.From)
+	sc.mutex.Unlock()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send raw transaction: %w", err)
+	}
+
+	tx.EthTx = signedTx
+	tx.Hash = signedTx.Hash()
+
+	sc.pending.Send(tx.Hash)
+	if sc.period == 0 {
+		sc.Mine()
+	}
+
+	return tx.Hash, nil
+}
+
+// addToPool applique les règles d'admission du txpool de Geth ; le verrou doit être tenu
+func (sc *SimulatedClient) addToPool(ctx context.Context, tx *types.Transaction, from common.Address) error {
+	head := sc.backend.Blockchain().CurrentBlock()
+
+	nonce, err := sc.backend.NonceAt(ctx, from, nil)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() < nonce {
+		return core.ErrNonceTooLow
+	}
+
+	if tx.Gas() > head.GasLimit() {
+		return core.ErrGasLimit
+	}
+	intrinsic, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, true)
+	if err != nil {
+		return err
+	}
+	if tx.Gas() < intrinsic {
+		return core.ErrIntrinsicGas
+	}
+	if tx.GasFeeCap().Cmp(tx.GasTipCap()) < 0 {
+		return core.ErrTipAboveFeeCap
+	}
+
+	balance, err := sc.backend.BalanceAt(ctx, from, nil)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(tx.Cost()) < 0 {
+		return core.ErrInsufficientFunds
+	}
+
+	if existing, exists := sc.pool[from][tx.Nonce()]; exists {
+		if existing.Hash() == tx.Hash() {
+			return core.ErrAlreadyKnown
+		}
+		// Même règle que --txpool.pricebump : chaque composante des frais augmente d'au moins 10 %
+		threshold := big.NewInt(100 + MinReplacementBumpPercent)
+		for _, fees := range [][2]*big.Int{{existing.GasTipCap(), tx.GasTipCap()}, {existing.GasFeeCap(), tx.GasFeeCap()}} {
+			minimum := new(big.Int).Mul(fees[0], threshold)
+			if new(big.Int).Mul(fees[1], big.NewInt(100)).Cmp(minimum) < 0 {
+				return ErrReplacementUnderpriced
+			}
+		}
+	}
+
+	if sc.pool[from] == nil {
+		sc.pool[from] = make(map[uint64]*types.Transaction)
+	}
+	sc.pool[from][tx.Nonce()] = tx
+
+	return nil
+}
+
+// pendingNonce retourne le nonce du compte suivi de ses transactions contiguës du mempool ; le verrou doit être tenu
+func (sc *SimulatedClient) pendingNonce(ctx context.Context, address common.Address) (uint64, error) {
+	nonce, err := sc.backend.NonceAt(ctx, address, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	for {
+		if _, exists := sc.pool[address][nonce]; !exists {
+			return nonce, nil
+		}
+		nonce++
+	}
+}
+
+// poolTransaction cherche une transaction du mempool par son hash
+func (sc *SimulatedClient) poolTransaction(txHash common.Hash) (*types.Transaction, common.Address, bool) {
+	sc.mutex.RLock()
+	defer sc.mutex.RUnlock()
+
+	for sender, byNonce := range sc.pool {
+		for _, tx := range byNonce {
+			if tx.Hash() == txHash {
+				return tx, sender, true
+			}
+		}
+	}
+	return nil, common.Address{}, false
+}
+
+// poolContent sépare le mempool en transactions exécutables (nonces contigus depuis celui du compte)
+// et en attente derrière un trou de nonce, triées par nonce
+func (sc *SimulatedClient) poolContent(ctx context.Context) (map[common.Address][]ports.PoolTransaction, map[common.Address][]ports.PoolTransaction) {
+	sc.mutex.RLock()
+	defer sc.mutex.RUnlock()
+
+	pending := make(map[common.Address][]ports.PoolTransaction)
+	queued := make(map[common.Address][]ports.PoolTransaction)
+
+	for sender, byNonce := range sc.pool {
+		nonce, _ := sc.backend.NonceAt(ctx, sender, nil)
+
+		nonces := make([]uint64, 0, len(byNonce))
+		for txNonce := range byNonce {
+			nonces = append(nonces, txNonce)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		for _, txNonce := range nonces {
+			poolTx := toPoolTransaction(byNonce[txNonce], sender)
+			if txNonce == nonce {
+				pending[sender] = append(pending[sender], poolTx)
+				nonce++
+			} else {
+				queued[sender] = append(queued[sender], poolTx)
+			}
+		}
+	}
+
+	return pending, queued
+}
+
+// estimateGas estime le gas sur l'état en attente ; to vaut nil pour un déploiement
+func (sc *SimulatedClient) estimateGas(ctx context.Context, tx *entities.Transaction, to *common.Address) (uint64, error) {
+	gas, err := sc.backend.EstimateGas(ctx, ethereum.CallMsg{
+		From:  tx.From,
+		To:    to,
+		Value: tx.Value,
+		Data:  tx.Data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+
+	return withGasMargin(gas, tx.Data), nil
+}
+
+// inTurnSigner retourne le validateur Clique en tour pour un bloc
+func (sc *SimulatedClient) inTurnSigner(number uint64) common.Address {
+	if len(sc.signers) == 0 {
+		return common.Address{}
+	}
+	return sc.signers[number%uint64(len(sc.signers))]
+}
+
+// genesisSigners lit les validateurs de l'extraData Clique d'un genesis : 32 octets de vanity,
+// les adresses, puis 65 octets de signature vide
+func genesisSigners(extraData []byte) ([]common.Address, error) {
+	if len(extraData) == 0 {
+		return nil, nil
+	}
+	if len(extraData) < 32+cliqueExtraSeal || (len(extraData)-32-cliqueExtraSeal)%common.AddressLength != 0 {
+		return nil, fmt.Errorf("invalid clique extraData in genesis (%d bytes)", len(extraData))
+	}
+
+	signersData := extraData[32 : len(extraData)-cliqueExtraSeal]
+	signers := make([]common.Address, 0, len(signersData)/common.AddressLength)
+	for i := 0; i < len(signersData); i += common.AddressLength {
+		signers = append(signers, common.BytesToAddress(signersData[i:i+common.AddressLength]))
+	}
+	sortAddresses(signers)
+
+	return signers, nil
+}
+
+// toPoolTransaction convertit une transaction go-ethereum du mempool vers le type du domaine
+func toPoolTransaction(tx *types.Transaction, from common.Address) ports.PoolTransaction {
+	info := toTransactionInfo(tx, from)
+	return ports.PoolTransaction{
+		Hash:      info.Hash,
+		From:      info.From,
+		To:        info.To,
+		Nonce:     info.Nonce,
+		Value:     info.Value,
+		Gas:       info.Gas,
+		Type:      info.Type,
+		GasPrice:  info.GasPrice,
+		GasTipCap: info.GasTipCap,
+		GasFeeCap: info.GasFeeCap,
+	}
+}
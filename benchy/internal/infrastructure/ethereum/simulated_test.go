@@ -0,0 +1,287 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/infrastructure/config"
+	"benchy/internal/infrastructure/ethereum/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// testChain est une chaîne simulée dont les blocs ne sont scellés que par Mine
+type testChain struct {
+	client *SimulatedClient
+	alice  common.Address // Validateur financé, clé enregistrée
+	bob    common.Address // Validateur financé, sans clé enregistrée
+}
+
+// newTestChain crée une chaîne à deux validateurs depuis un genesis benchy ; la période d'une heure laisse
+// les transactions dans le mempool jusqu'à l'appel de Mine
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+
+	aliceKey := mustGenerateKey(t)
+	bobKey := mustGenerateKey(t)
+
+	generator := config.NewGenesisGenerator()
+	generator.AddValidator(crypto.PubkeyToAddress(aliceKey.PublicKey))
+	generator.AddValidator(crypto.PubkeyToAddress(bobKey.PublicKey))
+	genesis, err := generator.GenerateGenesis()
+	if err != nil {
+		t.Fatalf("GenerateGenesis: %v", err)
+	}
+
+	client, err := NewSimulatedClient(genesis, time.Hour)
+	if err != nil {
+		t.Fatalf("NewSimulatedClient: %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return &testChain{
+		client: client,
+		alice:  client.AddAccount(aliceKey),
+		bob:    crypto.PubkeyToAddress(bobKey.PublicKey),
+	}
+}
+
+func mustGenerateKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return key
+}
+
+// sendEther envoie value wei d'Alice à Bob et retourne la transaction signée
+func (tc *testChain) sendEther(t *testing.T, value *big.Int) *entities.Transaction {
+	t.Helper()
+	tx := entities.NewTransaction(tc.alice, tc.bob, value, entities.TxTypeTransfer)
+	if _, err := tc.client.SendTransaction(context.Background(), "", tx); err != nil {
+		t.Fatalf("SendTransaction: %v", err)
+	}
+	return tx
+}
+
+func TestSimulatedClientERC20(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	supply := contracts.ToTokenUnits(1000000)
+	amount := contracts.ToTokenUnits(1000)
+
+	code, err := contracts.BYTokenDeployCode(supply)
+	if err != nil {
+		t.Fatalf("BYTokenDeployCode: %v", err)
+	}
+	token, deployHash, err := chain.client.DeployContract(ctx, "", code, chain.alice)
+	if err != nil {
+		t.Fatalf("DeployContract: %v", err)
+	}
+	chain.client.Mine()
+
+	receipt, err := chain.client.GetTransactionReceipt(ctx, "", deployHash)
+	if err != nil {
+		t.Fatalf("deployment receipt: %v", err)
+	}
+	if receipt.Status != 1 || receipt.ContractAddress != token {
+		t.Fatalf("deployment receipt: status %d, contract %s, want 1 and %s", receipt.Status, receipt.ContractAddress.Hex(), token.Hex())
+	}
+
+	// Le binding abigen lit le contrat déployé par le client
+	caller, err := contracts.NewBYTokenCaller(token, chain.client.backend)
+	if err != nil {
+		t.Fatalf("NewBYTokenCaller: %v", err)
+	}
+	symbol, err := caller.Symbol(&bind.CallOpts{Context: ctx})
+	if err != nil || symbol != contracts.BYTokenSymbol {
+		t.Fatalf("symbol: %q, %v, want %q", symbol, err, contracts.BYTokenSymbol)
+	}
+	totalSupply, err := caller.TotalSupply(&bind.CallOpts{Context: ctx})
+	if err != nil || totalSupply.Cmp(supply) != 0 {
+		t.Fatalf("totalSupply: %v, %v, want %v", totalSupply, err, supply)
+	}
+
+	transferHash, err := chain.client.TransferToken(ctx, "", token, chain.alice, chain.bob, amount)
+	if err != nil {
+		t.Fatalf("TransferToken: %v", err)
+	}
+	chain.client.Mine()
+
+	receipt, err = chain.client.GetTransactionReceipt(ctx, "", transferHash)
+	if err != nil {
+		t.Fatalf("transfer receipt: %v", err)
+	}
+	if receipt.Status != 1 || len(receipt.Events) != 1 {
+		t.Fatalf("transfer receipt: status %d, %d events, want 1 and 1", receipt.Status, len(receipt.Events))
+	}
+	event := receipt.Events[0]
+	if event.Name != "Transfer" || event.Address != token || event.Args["to"] != chain.bob {
+		t.Fatalf("transfer event: %s from %s to %v", event.Name, event.Address.Hex(), event.Args["to"])
+	}
+	if value, _ := event.Args["value"].(*big.Int); value == nil || value.Cmp(amount) != 0 {
+		t.Fatalf("transfer event value: %v, want %v", event.Args["value"], amount)
+	}
+
+	for _, holder := range []struct {
+		address common.Address
+		want    *big.Int
+	}{
+		{chain.alice, new(big.Int).Sub(supply, amount)},
+		{chain.bob, amount},
+	} {
+		balance, err := chain.client.GetTokenBalance(ctx, "", token, holder.address)
+		if err != nil {
+			t.Fatalf("GetTokenBalance: %v", err)
+		}
+		if balance.Cmp(holder.want) != 0 {
+			t.Errorf("token balance of %s: %v, want %v", holder.address.Hex(), balance, holder.want)
+		}
+	}
+
+	// Un transfert au-delà de la balance revert : l'estimation de gas le refuse avant l'envoi
+	if _, err := chain.client.TransferToken(ctx, "", token, chain.alice, chain.bob, new(big.Int).Add(supply, big.NewInt(1))); err == nil {
+		t.Error("TransferToken above the balance: want an error")
+	}
+}
+
+func TestSimulatedClientNonces(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+
+	// Les transactions en attente enchaînent les nonces pending
+	var txs []*entities.Transaction
+	for i := 0; i < 3; i++ {
+		tx := chain.sendEther(t, big.NewInt(params.GWei))
+		if tx.Nonce != uint64(i) {
+			t.Fatalf("transaction #%d: nonce %d, want %d", i, tx.Nonce, i)
+		}
+		txs = append(txs, tx)
+	}
+	nonce, err := chain.client.GetNonce(ctx, "", chain.alice)
+	if err != nil || nonce != 3 {
+		t.Fatalf("pending nonce: %d, %v, want 3", nonce, err)
+	}
+	if count, _ := chain.client.GetPendingTransactionCount(ctx, ""); count != 3 {
+		t.Fatalf("pending transactions: %d, want 3", count)
+	}
+
+	header := chain.client.Mine()
+
+	for i, tx := range txs {
+		receipt, err := chain.client.GetTransactionReceipt(ctx, "", tx.Hash)
+		if err != nil {
+			t.Fatalf("receipt #%d: %v", i, err)
+		}
+		if receipt.BlockNumber != header.Number || receipt.TransactionIndex != uint(i) || receipt.From != chain.alice {
+			t.Errorf("receipt #%d: block %d index %d from %s", i, receipt.BlockNumber, receipt.TransactionIndex, receipt.From.Hex())
+		}
+		if status, _ := chain.client.GetTransactionStatus(ctx, "", tx.Hash); status != entities.TxStatusConfirmed {
+			t.Errorf("status #%d: %s, want %s", i, status, entities.TxStatusConfirmed)
+		}
+	}
+	if count, _ := chain.client.GetPendingTransactionCount(ctx, ""); count != 0 {
+		t.Errorf("pending transactions after mining: %d, want 0", count)
+	}
+
+	// Le nonce suivant part du compte une fois le mempool vidé
+	if tx := chain.sendEther(t, big.NewInt(params.GWei)); tx.Nonce != 3 {
+		t.Errorf("nonce after mining: %d, want 3", tx.Nonce)
+	}
+}
+
+func TestSimulatedClientReplacement(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+
+	original := chain.sendEther(t, big.NewInt(params.Ether))
+	replacement, err := chain.client.ReplaceTransaction(ctx, "", original, entities.ReplaceSpeedUp)
+	if err != nil {
+		t.Fatalf("ReplaceTransaction: %v", err)
+	}
+	if replacement.Nonce != original.Nonce || replacement.Replaces != original.Hash {
+		t.Fatalf("replacement: nonce %d replaces %s, want %d and %s", replacement.Nonce, replacement.Replaces.Hex(), original.Nonce, original.Hash.Hex())
+	}
+	minimum := new(big.Int).Div(new(big.Int).Mul(original.GasTipCap, big.NewInt(100+MinReplacementBumpPercent)), big.NewInt(100))
+	if replacement.GasTipCap.Cmp(minimum) < 0 {
+		t.Fatalf("replacement tip %v below the %d%% bump of %v", replacement.GasTipCap, MinReplacementBumpPercent, original.GasTipCap)
+	}
+
+	// Un second remplaçant aux mêmes frais est refusé comme par le txpool
+	underpriced := *replacement
+	underpriced.Value = big.NewInt(1)
+	if _, err := chain.client.signAndSend(ctx, &underpriced, &underpriced.To, true); !errors.Is(err, ErrReplacementUnderpriced) {
+		t.Fatalf("replacement without a fee bump: %v, want %v", err, ErrReplacementUnderpriced)
+	}
+
+	// Le tracker rend le remplaçant une fois miné, et marque l'original comme remplacé
+	tracker := NewTransactionTracker(chain.client, "", DefaultConfirmations)
+	tracker.SetInterval(10 * time.Millisecond)
+	done := make(chan error, 1)
+	var mined *entities.Transaction
+	go func() {
+		var err error
+		mined, err = tracker.WaitForReplacement(ctx, original, replacement)
+		done <- err
+	}()
+	chain.client.Mine()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WaitForReplacement: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("WaitForReplacement did not return")
+	}
+	if mined.Hash != replacement.Hash || original.Status != entities.TxStatusReplaced {
+		t.Fatalf("mined %s (original %s), want the replacement", mined.Hash.Hex(), original.Status)
+	}
+
+	if _, err := chain.client.GetTransactionReceipt(ctx, "", original.Hash); err == nil {
+		t.Error("the replaced transaction has a receipt")
+	}
+	if _, err := chain.client.ReplaceTransaction(ctx, "", replacement, entities.ReplaceCancel); !errors.Is(err, ErrAlreadyMined) {
+		t.Errorf("replacing a mined transaction: %v, want %v", err, ErrAlreadyMined)
+	}
+}
+
+func TestSimulatedClientCancel(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+
+	before, err := chain.client.GetBalance(ctx, "", chain.bob)
+	if err != nil {
+		t.Fatalf("GetBalance: %v", err)
+	}
+
+	original := chain.sendEther(t, big.NewInt(params.Ether))
+	cancel, err := chain.client.ReplaceTransaction(ctx, "", original, entities.ReplaceCancel)
+	if err != nil {
+		t.Fatalf("ReplaceTransaction: %v", err)
+	}
+	if cancel.To != chain.alice || cancel.Value.Sign() != 0 {
+		t.Fatalf("cancel: %s wei to %s, want 0 to the sender", cancel.Value, cancel.To.Hex())
+	}
+	chain.client.Mine()
+
+	receipt, err := chain.client.GetTransactionReceipt(ctx, "", cancel.Hash)
+	if err != nil || receipt.Status != 1 {
+		t.Fatalf("cancel receipt: %+v, %v", receipt, err)
+	}
+	after, err := chain.client.GetBalance(ctx, "", chain.bob)
+	if err != nil {
+		t.Fatalf("GetBalance: %v", err)
+	}
+	if after.Cmp(before) != 0 {
+		t.Errorf("bob received %v wei from a cancelled transfer", new(big.Int).Sub(after, before))
+	}
+}
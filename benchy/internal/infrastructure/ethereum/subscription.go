@@ -0,0 +1,217 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"benchy/internal/domain/ports"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// minReconnectDelay et maxReconnectDelay bornent le backoff de reconnexion
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+	// stableSubscriptionAge est la durée après laquelle un abonnement est considéré stable
+	stableSubscriptionAge = 10 * time.Second
+	// maxReconnectAttempts borne les reconnexions consécutives avant d'abandonner l'abonnement
+	maxReconnectAttempts = 5
+)
+
+// wsSubscription implémente ports.Subscription avec reconnexion automatique
+type wsSubscription struct {
+	cancel context.CancelFunc
+	errCh  chan error
+	once   sync.Once
+}
+
+// Unsubscribe arrête l'abonnement
+func (ws *wsSubscription) Unsubscribe() {
+	ws.cancel()
+}
+
+// Err retourne le canal d'erreur de l'abonnement
+func (ws *wsSubscription) Err() <-chan error {
+	return ws.errCh
+}
+
+// stop termine l'abonnement en publiant éventuellement une erreur
+func (ws *wsSubscription) stop(err error) {
+	ws.once.Do(func() {
+		if err != nil {
+			ws.errCh <- err
+		}
+		close(ws.errCh)
+	})
+}
+
+// subscribeFunc abonne une connexion et relaie les événements jusqu'à une erreur
+type subscribeFunc func(ctx context.Context, rpcClient *rpc.Client) error
+
+// SubscribeNewHeads s'abonne aux nouveaux blocs (eth_subscribe newHeads)
+func (ec *EthereumClient) SubscribeNewHeads(ctx context.Context, wsURL string, heads chan<- *ports.BlockHeader) (ports.Subscription, error) {
+	return ec.subscribe(ctx, wsURL, func(ctx context.Context, rpcClient *rpc.Client) error {
+		headers := make(chan *types.Header, 16)
+		sub, err := rpcClient.EthSubscribe(ctx, headers, "newHeads")
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to newHeads: %w", err)
+		}
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case header := <-headers:
+				select {
+				case heads <- toBlockHeader(header):
+				case <-ctx.Done():
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	})
+}
+
+// SubscribePendingTransactions s'abonne aux transactions entrant dans la mempool
+func (ec *EthereumClient) SubscribePendingTransactions(ctx context.Context, wsURL string, hashes chan<- common.Hash) (ports.Subscription, error) {
+	return ec.subscribe(ctx, wsURL, func(ctx context.Context, rpcClient *rpc.Client) error {
+		pending := make(chan common.Hash, 256)
+		sub, err := rpcClient.EthSubscribe(ctx, pending, "newPendingTransactions")
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to newPendingTransactions: %w", err)
+		}
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case hash := <-pending:
+				select {
+				case hashes <- hash:
+				case <-ctx.Done():
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	})
+}
+
+// subscribe ouvre la connexion de l'abonnement puis le maintient en tâche de fond. Chaque abonnement a
+// sa propre connexion WebSocket : la fermer en cas de coupure ne touche pas les autres appels au node.
+func (ec *EthereumClient) subscribe(ctx context.Context, wsURL string, run subscribeFunc) (ports.Subscription, error) {
+	// La première connexion doit réussir pour signaler une URL invalide à l'appelant
+	rpcClient, err := rpc.DialContext(ctx, wsURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", wsURL, err)
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := &wsSubscription{
+		cancel: cancel,
+		errCh:  make(chan error, 1),
+	}
+
+	go maintainSubscription(subCtx, wsURL, rpcClient, run, sub)
+
+	return sub, nil
+}
+
+// maintainSubscription relance l'abonnement avec un backoff exponentiel après chaque coupure. Il se termine
+// en publiant l'erreur sur Err quand le node refuse l'abonnement, ou après maxReconnectAttempts échecs
+// consécutifs.
+func maintainSubscription(ctx context.Context, wsURL string, rpcClient *rpc.Client, run subscribeFunc, sub *wsSubscription) {
+	delay := minReconnectDelay
+	failures := 0
+
+	for {
+		startedAt := time.Now()
+
+		var err error
+		if rpcClient == nil {
+			rpcClient, err = rpc.DialContext(ctx, wsURL)
+		}
+		if err == nil {
+			err = run(ctx, rpcClient)
+			// Connexion cassée (node redémarré...) : on la jette pour en rouvrir une neuve
+			rpcClient.Close()
+			rpcClient = nil
+		}
+
+		if ctx.Err() != nil {
+			sub.stop(nil)
+			return
+		}
+		if err == nil {
+			// Le node a fermé l'abonnement sans erreur (arrêt du serveur WebSocket)
+			err = errors.New("connection closed by the node")
+		}
+		if isPermanentSubscriptionError(err) {
+			sub.stop(fmt.Errorf("subscription to %s refused: %w", wsURL, err))
+			return
+		}
+
+		if time.Since(startedAt) > stableSubscriptionAge {
+			delay = minReconnectDelay
+			failures = 0
+		}
+		failures++
+		if failures > maxReconnectAttempts {
+			sub.stop(fmt.Errorf("subscription to %s lost after %d attempts: %w", wsURL, maxReconnectAttempts, err))
+			return
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			sub.stop(nil)
+			return
+		}
+
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// isPermanentSubscriptionError détecte un abonnement que le node ne pourra jamais accepter : URL sans
+// notifications (HTTP), méthode ou type d'abonnement inconnu
+func isPermanentSubscriptionError(err error) bool {
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case -32601, -32602: // Méthode introuvable, paramètres invalides
+			return true
+		}
+	}
+	return false
+}
+
+// toBlockHeader convertit un en-tête go-ethereum vers le type du domaine
+func toBlockHeader(header *types.Header) *ports.BlockHeader {
+	return &ports.BlockHeader{
+		Number:     header.Number.Uint64(),
+		Hash:       header.Hash(),
+		ParentHash: header.ParentHash,
+		Timestamp:  header.Time,
+		GasLimit:   header.GasLimit,
+		GasUsed:    header.GasUsed,
+		BaseFee:    header.BaseFee,
+		Miner:      header.Coinbase,
+	}
+}
@@ -0,0 +1,373 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"benchy/internal/domain/ports"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultConfirmations est la profondeur par défaut avant de considérer une transaction confirmée
+	DefaultConfirmations = 1
+	// defaultTrackerInterval est l'intervalle de polling par défaut du tracker
+	defaultTrackerInterval = time.Second
+	// headsFallbackInterval est l'intervalle de polling quand le tracker suit les nouveaux blocs : il ne
+	// sert plus qu'à rattraper un bloc manqué pendant une reconnexion
+	headsFallbackInterval = 15 * time.Second
+)
+
+// TransactionTracker suit le cycle de vie des transactions soumises
+type TransactionTracker struct {
+	ethService    ports.EthereumService
+	nodeURL       string
+	confirmations uint64
+	interval      time.Duration
+	newBlock      chan struct{} // Signalé à chaque nouveau bloc reçu (nil sans FollowHeads)
+	tracked       map[common.Hash]*trackedTransaction
+	reorgs        map[common.Hash]int
+	mutex         sync.Mutex
+	pollMutex     sync.Mutex // Sérialise Poll : Run et les attentes peuvent interroger en même temps
+}
+
+// trackedTransaction représente l'état de suivi d'une transaction
+type trackedTransaction struct {
+	tx            *entities.Transaction
+	seenBlockHash common.Hash
+	done          chan struct{}
+}
+
+// NewTransactionTracker crée un tracker confirmant après `confirmations` blocs
+func NewTransactionTracker(ethService ports.EthereumService, nodeURL string, confirmations uint64) *TransactionTracker {
+	if confirmations == 0 {
+		confirmations = DefaultConfirmations
+	}
+
+	return &TransactionTracker{
+		ethService:    ethService,
+		nodeURL:       nodeURL,
+		confirmations: confirmations,
+		interval:      defaultTrackerInterval,
+		tracked:       make(map[common.Hash]*trackedTransaction),
+		reorgs:        make(map[common.Hash]int),
+	}
+}
+
+// SetInterval modifie l'intervalle de polling
+func (tt *TransactionTracker) SetInterval(interval time.Duration) {
+	tt.interval = interval
+}
+
+// FollowHeads met à jour les transactions suivies à chaque bloc reçu sur heads (abonnement newHeads),
+// jusqu'à l'annulation de ctx ; le polling ne sert plus que de filet de sécurité. À appeler avant
+// Run ou WaitForConfirmation.
+func (tt *TransactionTracker) FollowHeads(ctx context.Context, heads <-chan *ports.BlockHeader) {
+	tt.newBlock = make(chan struct{}, 1)
+	tt.interval = headsFallbackInterval
+
+	// heads est vidé en continu pour ne jamais bloquer l'abonnement : les blocs reçus entre deux
+	// attentes se résument en un seul signal
+	go func() {
+		for {
+			select {
+			case <-heads:
+				select {
+				case tt.newBlock <- struct{}{}:
+				default:
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Track ajoute une transaction soumise au suivi
+func (tt *TransactionTracker) Track(tx *entities.Transaction) {
+	tt.track(tx)
+}
+
+// track ajoute une transaction au suivi et retourne son entrée ; une transaction déjà dans un état final
+// n'est pas suivie à nouveau (nil)
+func (tt *TransactionTracker) track(tx *entities.Transaction) *trackedTransaction {
+	tt.mutex.Lock()
+	defer tt.mutex.Unlock()
+
+	if t, exists := tt.tracked[tx.Hash]; exists {
+		return t
+	}
+	if isFinalStatus(tx.Status) {
+		return nil
+	}
+
+	t := &trackedTransaction{
+		tx:   tx,
+		done: make(chan struct{}),
+	}
+	tt.tracked[tx.Hash] = t
+	return t
+}
+
+// finish retire une transaction du suivi et réveille ses attentes, une seule fois même si plusieurs
+// Poll la voient finale
+func (tt *TransactionTracker) finish(t *trackedTransaction) {
+	tt.mutex.Lock()
+	defer tt.mutex.Unlock()
+
+	if current, exists := tt.tracked[t.tx.Hash]; exists && current == t {
+		delete(tt.tracked, t.tx.Hash)
+		close(t.done)
+	}
+}
+
+// Pending retourne le nombre de transactions encore suivies
+func (tt *TransactionTracker) Pending() int {
+	tt.mutex.Lock()
+	defer tt.mutex.Unlock()
+
+	return len(tt.tracked)
+}
+
+// Reorgs retourne le nombre de fois où le reçu d'une transaction a disparu ou changé de bloc
+func (tt *TransactionTracker) Reorgs(txHash common.Hash) int {
+	tt.mutex.Lock()
+	defer tt.mutex.Unlock()
+
+	return tt.reorgs[txHash]
+}
+
+// Run interroge le node jusqu'à l'annulation du contexte
+func (tt *TransactionTracker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(tt.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// Les erreurs ponctuelles (node indisponible) sont retentées au tick suivant
+			tt.Poll(ctx)
+		case <-tt.newBlock:
+			tt.Poll(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Poll met à jour une fois toutes les transactions suivies
+func (tt *TransactionTracker) Poll(ctx context.Context) error {
+	tt.pollMutex.Lock()
+	defer tt.pollMutex.Unlock()
+
+	latestBlock, err := tt.ethService.GetLatestBlockNumber(ctx, tt.nodeURL)
+	if err != nil {
+		return fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	tt.mutex.Lock()
+	tracked := make([]*trackedTransaction, 0, len(tt.tracked))
+	for _, t := range tt.tracked {
+		tracked = append(tracked, t)
+	}
+	tt.mutex.Unlock()
+
+	var lastErr error
+	for _, t := range tracked {
+		final, err := tt.update(ctx, t, latestBlock)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if final {
+			tt.finish(t)
+		}
+	}
+
+	return lastErr
+}
+
+// WaitForConfirmation suit une transaction jusqu'à son état final (confirmée, échouée ou remplacée)
+func (tt *TransactionTracker) WaitForConfirmation(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error) {
+	tracked := tt.track(tx)
+	if tracked == nil {
+		return tx, nil
+	}
+
+	if _, err := tt.waitAny(ctx, tracked); err != nil {
+		return tx, err
+	}
+	return tx, nil
+}
+
+// WaitForReplacement suit une transaction en attente et son remplaçant (même nonce) jusqu'à ce que l'un
+// des deux soit miné ; l'autre passe en TxStatusReplaced. Retourne la transaction minée.
+func (tt *TransactionTracker) WaitForReplacement(ctx context.Context, original, replacement *entities.Transaction) (*entities.Transaction, error) {
+	// Les deux sont suivies dès le départ : celle qui est minée la première tranche
+	pending := make([]*trackedTransaction, 0, 2)
+	for _, tx := range []*entities.Transaction{original, replacement} {
+		t := tt.track(tx)
+		if t == nil {
+			if tx.Status != entities.TxStatusReplaced {
+				tt.markReplaced(original, replacement, tx)
+				return tx, nil
+			}
+			continue
+		}
+		pending = append(pending, t)
+	}
+
+	for len(pending) > 0 {
+		i, err := tt.waitAny(ctx, pending...)
+		if err != nil {
+			return nil, err
+		}
+
+		// Confirmée ou échouée, elle a consommé le nonce ; remplacée, on attend l'autre
+		if tx := pending[i].tx; tx.Status != entities.TxStatusReplaced {
+			tt.markReplaced(original, replacement, tx)
+			return tx, nil
+		}
+		pending = append(pending[:i], pending[i+1:]...)
+	}
+
+	return nil, fmt.Errorf("neither %s nor its replacement %s was mined", original.Hash.Hex(), replacement.Hash.Hex())
+}
+
+// markReplaced marque comme remplacée celle des deux transactions qui n'a pas été minée et la retire du
+// suivi ; pollMutex l'isole d'un Poll en cours
+func (tt *TransactionTracker) markReplaced(original, replacement, mined *entities.Transaction) {
+	other := original
+	if mined == original {
+		other = replacement
+	}
+
+	tt.pollMutex.Lock()
+	defer tt.pollMutex.Unlock()
+
+	tt.mutex.Lock()
+	t, exists := tt.tracked[other.Hash]
+	tt.mutex.Unlock()
+	if exists {
+		tt.finish(t)
+	}
+	if !isFinalStatus(other.Status) {
+		other.UpdateStatus(entities.TxStatusReplaced)
+	}
+}
+
+// waitAny interroge le node jusqu'à ce que l'une des transactions atteigne un état final et retourne son index
+func (tt *TransactionTracker) waitAny(ctx context.Context, tracked ...*trackedTransaction) (int, error) {
+	ticker := time.NewTicker(tt.interval)
+	defer ticker.Stop()
+
+	for {
+		for i, t := range tracked {
+			select {
+			case <-t.done:
+				return i, nil
+			default:
+			}
+		}
+
+		select {
+		case <-ticker.C:
+			tt.Poll(ctx)
+		case <-tt.newBlock:
+			tt.Poll(ctx)
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// update rafraîchit une transaction et retourne true si son état est final
+func (tt *TransactionTracker) update(ctx context.Context, t *trackedTransaction, latestBlock uint64) (bool, error) {
+	tx := t.tx
+
+	receipt, err := tt.ethService.GetTransactionReceipt(ctx, tt.nodeURL, tx.Hash)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return false, err
+	}
+
+	if receipt == nil {
+		// Le reçu a disparu : la transaction a été sortie de la chaîne par une réorganisation
+		if t.seenBlockHash != (common.Hash{}) {
+			tt.recordReorg(tx.Hash)
+			t.seenBlockHash = common.Hash{}
+			tx.BlockNumber = 0
+			tx.BlockHash = common.Hash{}
+			tx.UpdateStatus(entities.TxStatusPending)
+		}
+
+		return tt.checkReplaced(ctx, tx)
+	}
+
+	// Même transaction incluse dans un autre bloc
+	if t.seenBlockHash != (common.Hash{}) && t.seenBlockHash != receipt.BlockHash {
+		tt.recordReorg(tx.Hash)
+	}
+	t.seenBlockHash = receipt.BlockHash
+
+	tx.BlockNumber = receipt.BlockNumber
+	tx.BlockHash = receipt.BlockHash
+	tx.TxIndex = receipt.TransactionIndex
+	tx.GasUsed = receipt.GasUsed
+
+	if latestBlock+1 < receipt.BlockNumber+tt.confirmations {
+		return false, nil
+	}
+
+	if receipt.Status == 1 {
+		tx.UpdateStatus(entities.TxStatusConfirmed)
+	} else {
+		tx.UpdateStatus(entities.TxStatusFailed)
+	}
+
+	return true, nil
+}
+
+// checkReplaced détecte une transaction remplacée (même nonce, hash différent)
+func (tt *TransactionTracker) checkReplaced(ctx context.Context, tx *entities.Transaction) (bool, error) {
+	_, err := tt.ethService.GetTransactionStatus(ctx, tt.nodeURL, tx.Hash)
+	if err == nil {
+		// Toujours connue du node (mempool ou reçu pas encore indexé)
+		return false, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return false, err
+	}
+
+	// Inconnue du node : si une transaction minée a consommé son nonce, elle a été remplacée. Le nonce
+	// pending ne suffit pas : il avance dès qu'un remplaçant entre dans le mempool.
+	nonce, err := tt.ethService.GetMinedNonce(ctx, tt.nodeURL, tx.From)
+	if err != nil {
+		return false, err
+	}
+
+	if nonce > tx.Nonce {
+		tx.UpdateStatus(entities.TxStatusReplaced)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// isFinalStatus retourne true pour les états qui ne changent plus
+func isFinalStatus(status entities.TransactionStatus) bool {
+	return status == entities.TxStatusConfirmed || status == entities.TxStatusFailed || status == entities.TxStatusReplaced
+}
+
+// recordReorg comptabilise une réorganisation touchant une transaction
+func (tt *TransactionTracker) recordReorg(txHash common.Hash) {
+	tt.mutex.Lock()
+	defer tt.mutex.Unlock()
+
+	tt.reorgs[txHash]++
+}
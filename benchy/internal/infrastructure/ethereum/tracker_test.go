@@ -0,0 +1,101 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"benchy/internal/domain/entities"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestTrackerConcurrentPolls(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	tracker := NewTransactionTracker(chain.client, "", DefaultConfirmations)
+
+	var txs []*entities.Transaction
+	for i := 0; i < 5; i++ {
+		tx := chain.sendEther(t, big.NewInt(params.GWei))
+		tracker.Track(tx)
+		txs = append(txs, tx)
+	}
+	chain.client.Mine()
+
+	// Plusieurs Poll voient les mêmes transactions finales : chaque entrée n'est fermée qu'une fois
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := tracker.Poll(ctx); err != nil {
+				t.Errorf("Poll: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if pending := tracker.Pending(); pending != 0 {
+		t.Fatalf("%d transactions still tracked, want 0", pending)
+	}
+	for i, tx := range txs {
+		if tx.Status != entities.TxStatusConfirmed {
+			t.Errorf("transaction #%d: %s, want %s", i, tx.Status, entities.TxStatusConfirmed)
+		}
+		// Une transaction finale n'est pas suivie à nouveau
+		if _, err := tracker.WaitForConfirmation(ctx, tx); err != nil {
+			t.Errorf("WaitForConfirmation #%d: %v", i, err)
+		}
+	}
+	if pending := tracker.Pending(); pending != 0 {
+		t.Errorf("%d transactions tracked again after WaitForConfirmation, want 0", pending)
+	}
+}
+
+func TestTrackerReplacementPendingInMempool(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	tracker := NewTransactionTracker(chain.client, "", DefaultConfirmations)
+
+	original := chain.sendEther(t, big.NewInt(params.Ether))
+	replacement, err := chain.client.ReplaceTransaction(ctx, "", original, entities.ReplaceSpeedUp)
+	if err != nil {
+		t.Fatalf("ReplaceTransaction: %v", err)
+	}
+	tracker.Track(original)
+
+	// Le remplaçant n'est que dans le mempool : le nonce pending a avancé, pas le nonce miné
+	if err := tracker.Poll(ctx); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if original.Status != entities.TxStatusPending || tracker.Pending() != 1 {
+		t.Fatalf("before mining: original %s, %d tracked, want pending and 1", original.Status, tracker.Pending())
+	}
+
+	tracker.SetInterval(10 * time.Millisecond)
+	done := make(chan error, 1)
+	var mined *entities.Transaction
+	go func() {
+		var err error
+		mined, err = tracker.WaitForReplacement(ctx, original, replacement)
+		done <- err
+	}()
+	chain.client.Mine()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WaitForReplacement: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("WaitForReplacement did not return")
+	}
+	if mined.Hash != replacement.Hash || original.Status != entities.TxStatusReplaced {
+		t.Fatalf("mined %s (original %s), want the replacement", mined.Hash.Hex(), original.Status)
+	}
+	if pending := tracker.Pending(); pending != 0 {
+		t.Errorf("%d transactions still tracked, want 0", pending)
+	}
+}
@@ -0,0 +1,201 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"benchy/internal/domain/ports"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// txPoolStatusJSON est la réponse de txpool_status (hexadécimal chez Geth, décimal chez Nethermind)
+type txPoolStatusJSON struct {
+	Pending hexOrNumber `json:"pending"`
+	Queued  hexOrNumber `json:"queued"`
+}
+
+// poolTransactionJSON est une transaction du mempool telle que renvoyée par txpool_content et eth_pendingTransactions
+type poolTransactionJSON struct {
+	Hash                 common.Hash     `json:"hash"`
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Value                *hexutil.Big    `json:"value"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	Type                 hexutil.Uint64  `json:"type"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+}
+
+// txPoolContentJSON est la réponse de txpool_content : expéditeur → nonce → transaction
+type txPoolContentJSON struct {
+	Pending map[common.Address]map[string]poolTransactionJSON `json:"pending"`
+	Queued  map[common.Address]map[string]poolTransactionJSON `json:"queued"`
+}
+
+// txPoolInspectJSON est la réponse de txpool_inspect : expéditeur → nonce → résumé
+type txPoolInspectJSON struct {
+	Pending map[common.Address]map[string]string `json:"pending"`
+	Queued  map[common.Address]map[string]string `json:"queued"`
+}
+
+// GetTxPoolStatus retourne le nombre de transactions pending et queued (txpool_status).
+// Sans module txpool, seul le nombre de transactions pending est connu.
+func (ec *EthereumClient) GetTxPoolStatus(ctx context.Context, nodeURL string) (*ports.TxPoolStatus, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw txPoolStatusJSON
+	if err := rpcClient.CallContext(ctx, &raw, "txpool_status"); err != nil {
+		pending, fallbackErr := ec.GetPendingTransactionCount(ctx, nodeURL)
+		if fallbackErr != nil {
+			return nil, fmt.Errorf("failed to get txpool status: %w", err)
+		}
+		return &ports.TxPoolStatus{Pending: pending, Queued: -1}, nil
+	}
+
+	return &ports.TxPoolStatus{
+		Pending: int(raw.Pending),
+		Queued:  int(raw.Queued),
+	}, nil
+}
+
+// GetTxPoolContent retourne les transactions du mempool par expéditeur (txpool_content).
+// Sans module txpool, les transactions pending sont lues avec eth_pendingTransactions.
+func (ec *EthereumClient) GetTxPoolContent(ctx context.Context, nodeURL string) (*ports.TxPoolContent, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw txPoolContentJSON
+	if err := rpcClient.CallContext(ctx, &raw, "txpool_content"); err != nil {
+		var pending []poolTransactionJSON
+		if fallbackErr := rpcClient.CallContext(ctx, &pending, "eth_pendingTransactions"); fallbackErr != nil {
+			return nil, fmt.Errorf("failed to get txpool content: %w", err)
+		}
+
+		raw.Pending = make(map[common.Address]map[string]poolTransactionJSON)
+		for _, tx := range pending {
+			if raw.Pending[tx.From] == nil {
+				raw.Pending[tx.From] = make(map[string]poolTransactionJSON)
+			}
+			raw.Pending[tx.From][fmt.Sprintf("%d", tx.Nonce)] = tx
+		}
+	}
+
+	return &ports.TxPoolContent{
+		Pending: toPoolTransactions(raw.Pending),
+		Queued:  toPoolTransactions(raw.Queued),
+	}, nil
+}
+
+// GetTxPoolInspect retourne un résumé textuel de chaque transaction du mempool (txpool_inspect).
+// Sans txpool_inspect, les résumés sont construits au format Geth à partir du contenu.
+func (ec *EthereumClient) GetTxPoolInspect(ctx context.Context, nodeURL string) (*ports.TxPoolInspect, error) {
+	rpcClient, err := ec.getRPCClient(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw txPoolInspectJSON
+	if err := rpcClient.CallContext(ctx, &raw, "txpool_inspect"); err == nil {
+		pending, err := toInspectSummaries(raw.Pending)
+		if err != nil {
+			return nil, err
+		}
+		queued, err := toInspectSummaries(raw.Queued)
+		if err != nil {
+			return nil, err
+		}
+		return &ports.TxPoolInspect{Pending: pending, Queued: queued}, nil
+	}
+
+	content, err := ec.GetTxPoolContent(ctx, nodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ports.TxPoolInspect{
+		Pending: summarizePoolTransactions(content.Pending),
+		Queued:  summarizePoolTransactions(content.Queued),
+	}, nil
+}
+
+// toPoolTransactions convertit le contenu brut d'une file du mempool, trié par nonce
+func toPoolTransactions(raw map[common.Address]map[string]poolTransactionJSON) map[common.Address][]ports.PoolTransaction {
+	result := make(map[common.Address][]ports.PoolTransaction, len(raw))
+	for sender, byNonce := range raw {
+		txs := make([]ports.PoolTransaction, 0, len(byNonce))
+		for _, tx := range byNonce {
+			txs = append(txs, ports.PoolTransaction{
+				Hash:      tx.Hash,
+				From:      sender,
+				To:        tx.To,
+				Nonce:     uint64(tx.Nonce),
+				Value:     (*big.Int)(tx.Value),
+				Gas:       uint64(tx.Gas),
+				Type:      uint8(tx.Type),
+				GasPrice:  (*big.Int)(tx.GasPrice),
+				GasTipCap: (*big.Int)(tx.MaxPriorityFeePerGas),
+				GasFeeCap: (*big.Int)(tx.MaxFeePerGas),
+			})
+		}
+		sort.Slice(txs, func(i, j int) bool { return txs[i].Nonce < txs[j].Nonce })
+		result[sender] = txs
+	}
+	return result
+}
+
+// toInspectSummaries convertit les clés de nonce de txpool_inspect
+func toInspectSummaries(raw map[common.Address]map[string]string) (map[common.Address]map[uint64]string, error) {
+	result := make(map[common.Address]map[uint64]string, len(raw))
+	for sender, byNonce := range raw {
+		result[sender] = make(map[uint64]string, len(byNonce))
+		for key, summary := range byNonce {
+			var nonce hexOrNumber
+			if err := nonce.UnmarshalJSON([]byte(key)); err != nil {
+				return nil, fmt.Errorf("failed to decode txpool inspect: %w", err)
+			}
+			result[sender][uint64(nonce)] = summary
+		}
+	}
+	return result, nil
+}
+
+// summarizePoolTransactions résume des transactions au format de txpool_inspect chez Geth :
+// "0xdest: 1000 wei + 21000 gas × 2000000000 wei"
+func summarizePoolTransactions(txs map[common.Address][]ports.PoolTransaction) map[common.Address]map[uint64]string {
+	result := make(map[common.Address]map[uint64]string, len(txs))
+	for sender, senderTxs := range txs {
+		result[sender] = make(map[uint64]string, len(senderTxs))
+		for _, tx := range senderTxs {
+			to := "contract creation"
+			if tx.To != nil {
+				to = tx.To.Hex()
+			}
+
+			value := new(big.Int)
+			if tx.Value != nil {
+				value = tx.Value
+			}
+
+			price := tx.GasFeeCap
+			if price == nil {
+				price = tx.GasPrice
+			}
+			if price == nil {
+				price = new(big.Int)
+			}
+
+			result[sender][tx.Nonce] = fmt.Sprintf("%s: %s wei + %d gas × %s wei", to, value, tx.Gas, price)
+		}
+	}
+	return result
+}
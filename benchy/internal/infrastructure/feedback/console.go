@@ -1,8 +1,10 @@
 package feedback
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
+	"golang.org/x/term"
 )
 
 // ConsoleFeedback implémente l'interface FeedbackService pour la console
@@ -104,9 +107,30 @@ func (cf *ConsoleFeedback) Confirm(ctx context.Context, message string) (bool, e
 // Input demande une saisie
 func (cf *ConsoleFeedback) Input(ctx context.Context, prompt string) (string, error) {
 	fmt.Printf("%s: ", prompt)
-	var input string
-	fmt.Scanln(&input)
-	return input, nil
+	// Toute la ligne est lue : une passphrase peut contenir des espaces
+	input, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(input, "\r\n"), nil
+}
+
+// SecretInput demande une saisie sans l'afficher ; hors terminal (entrée redirigée), la ligne est lue
+// comme avec Input
+func (cf *ConsoleFeedback) SecretInput(ctx context.Context, prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return cf.Input(ctx, prompt)
+	}
+
+	fmt.Printf("%s: ", prompt)
+	secret, err := term.ReadPassword(fd)
+	// Le retour à la ligne tapé par l'utilisateur n'a pas été affiché
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
 }
 
 // Types pour Progress et Spinner
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Flags des commandes contract
+	contractNode  string
+	contractFrom  string
+	contractName  string
+	contractAlias string
+	contractABI   string
+	contractValue string
+)
+
+// contractCmd représente le groupe de commandes contract
+var contractCmd = &cobra.Command{
+	Use:   "contract",
+	Short: "Deploy and interact with any contract from its ABI",
+	Long: `Deploy contracts from Foundry, Hardhat or solc artifacts and call them by method name:
+
+benchy contract deploy out/Counter.sol/Counter.json
+benchy contract deploy artifacts/Token.json "My Token" MTK 1000000 --as MTK
+benchy contract call Counter number
+benchy contract send Counter setNumber 42 --from bob
+benchy contract call BY balanceOf driss
+benchy contract list
+
+Arguments are encoded from the ABI: numbers in decimal or 0x hex, bytes in 0x hex,
+arrays as [a,b,c], and addresses as node names, contract names or 0x addresses.
+Put negative numbers after -- so they are not read as flags.
+Deployed contracts are stored by name in ~/.benchy/contracts.json.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return contractListCmd.RunE(cmd, args)
+	},
+}
+
+// contractDeployCmd représente la commande contract deploy
+var contractDeployCmd = &cobra.Command{
+	Use:   "deploy [artifact] [constructor args...]",
+	Short: "Deploy a contract from a compiled artifact",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleContractDeploy(context.Background(), contractFrom, args[0], contractName, contractAlias, args[1:])
+	},
+}
+
+// contractCallCmd représente la commande contract call
+var contractCallCmd = &cobra.Command{
+	Use:   "call [contract] [method] [args...]",
+	Short: "Call a read-only method and decode its return values",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleContractCall(context.Background(), contractNode, args[0], args[1], args[2:], contractABI)
+	},
+}
+
+// contractSendCmd représente la commande contract send
+var contractSendCmd = &cobra.Command{
+	Use:   "send [contract] [method] [args...]",
+	Short: "Send a transaction calling a method and show its events",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleContractSend(context.Background(), contractFrom, args[0], args[1], args[2:], contractValue, contractABI)
+	},
+}
+
+// contractListCmd représente la commande contract list
+var contractListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show the contracts deployed with benchy",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleContractList(context.Background())
+	},
+}
+
+func init() {
+	contractDeployCmd.Flags().StringVar(&contractFrom, "from", "alice", "Node whose key signs the deployment")
+	contractDeployCmd.Flags().StringVar(&contractName, "name", "", "Contract to deploy when the artifact holds several")
+	contractDeployCmd.Flags().StringVar(&contractAlias, "as", "", "Name to register the contract under (default: contract name)")
+
+	contractCallCmd.Flags().StringVar(&contractNode, "node", "alice", "Node to query")
+	contractCallCmd.Flags().StringVar(&contractABI, "abi", "", "Artifact or ABI file, for contracts not deployed by benchy")
+
+	contractSendCmd.Flags().StringVar(&contractFrom, "from", "alice", "Node whose key signs the transaction")
+	contractSendCmd.Flags().StringVar(&contractValue, "value", "0", "ETH sent with the call (payable methods)")
+	contractSendCmd.Flags().StringVar(&contractABI, "abi", "", "Artifact or ABI file, for contracts not deployed by benchy")
+
+	contractCmd.AddCommand(contractDeployCmd)
+	contractCmd.AddCommand(contractCallCmd)
+	contractCmd.AddCommand(contractSendCmd)
+	contractCmd.AddCommand(contractListCmd)
+}
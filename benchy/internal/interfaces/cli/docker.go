@@ -43,7 +43,8 @@ var launchRealCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		return handler.HandleLaunchNetwork(ctx)
+		mnemonic, accounts := launchAccounts(cmd)
+		return handler.HandleLaunchNetwork(ctx, launchTopology, mnemonic, accounts)
 	},
 }
 
@@ -51,6 +52,8 @@ func init() {
 	// Ajouter les sous-commandes docker
 	dockerCmd.AddCommand(checkDockerCmd)
 	dockerCmd.AddCommand(launchRealCmd)
+	launchRealCmd.Flags().StringVar(&launchTopology, "topology", "mesh", launchTopologyUsage)
+	addLaunchAccountFlags(launchRealCmd)
 	
 	// Ajouter docker aux commandes principales
 	rootCmd.AddCommand(dockerCmd)
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+// explorerNode est le node interrogé par les commandes block, tx et account
+var explorerNode string
+
+// blockCmd représente la commande block
+var blockCmd = &cobra.Command{
+	Use:   "block [number|latest]",
+	Short: "Show a block, its Clique signer and its transactions",
+	Long: `Show a block header, the Clique validator that sealed it and its transactions:
+
+benchy block                   Latest block
+benchy block 42                Block 42 (0x2a works too)
+benchy block latest --node elena`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		ref := "latest"
+		if len(args) == 1 {
+			ref = args[0]
+		}
+
+		return handler.HandleBlock(context.Background(), explorerNode, ref)
+	},
+}
+
+// txCmd représente la commande tx
+var txCmd = &cobra.Command{
+	Use:   "tx [hash]",
+	Short: "Show a transaction, its receipt and decoded logs",
+	Long: `Show a transaction, its receipt and decoded logs, or craft raw transactions:
+
+benchy tx 0x5c50…                    Look up a transaction
+benchy tx build --to bob --value 1   Unsigned transaction (RLP hex and signing hash)
+benchy tx sign 0x02f0… --from alice  Sign it with a benchy key
+benchy tx broadcast 0x02f8… --node elena`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleTx(context.Background(), explorerNode, args[0])
+	},
+}
+
+// accountCmd représente la commande account
+var accountCmd = &cobra.Command{
+	Use:   "account [node|address]",
+	Short: "Show the balance, nonce and token balances of an account",
+	Long: `Show an account by node name (alice, bob…) or address:
+
+benchy account driss
+benchy account 0x71562b71999873DB5b286dF957af199Ec94617F7`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleAccount(context.Background(), explorerNode, args[0])
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{blockCmd, txCmd, accountCmd} {
+		cmd.Flags().StringVar(&explorerNode, "node", "alice", "Node to query")
+	}
+}
@@ -3,9 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"benchy/internal/application/handlers"
+	"benchy/internal/domain/entities"
 	"github.com/spf13/cobra"
 )
 
@@ -48,3 +53,67 @@ var failureCmd = &cobra.Command{
 		return handler.HandleTemporaryFailure(ctx, nodeName)
 	},
 }
+
+var (
+	// netemDelay, netemJitter, netemLoss et netemRate décrivent la dégradation des liens
+	netemDelay  time.Duration
+	netemJitter time.Duration
+	netemLoss   string
+	netemRate   string
+	// netemDuration est la durée de la dégradation (0 : jusqu'à Ctrl+C)
+	netemDuration time.Duration
+	// netemClear retire seulement la dégradation laissée par une exécution interrompue
+	netemClear bool
+)
+
+// netemCmd représente la commande netem
+var netemCmd = &cobra.Command{
+	Use:   "netem [node...]",
+	Short: "Inject latency, jitter, packet loss or a rate limit on node links",
+	Long: `Degrade the links of nodes with tc netem to reproduce WAN conditions:
+
+benchy netem alice --delay 100ms --jitter 20ms --loss 2% --rate 1mbit
+benchy netem alice,bob --delay 250ms --duration 5m
+benchy netem --loss 5%                 All nodes, until Ctrl+C
+benchy netem --clear                   Remove the impairment of an interrupted run
+
+The impairment applies to the traffic leaving each node, set from a helper
+container that shares its network namespace (NET_ADMIN). JSON-RPC responses
+to the host are delayed too. Without --duration, it stays until Ctrl+C; in
+both cases it is removed before benchy returns.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		// Ctrl+C met fin à la dégradation, qui est retirée avant de rendre la main
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if netemClear {
+			return handler.HandleNetemClear(ctx, args)
+		}
+
+		loss, err := entities.ParseNetemLoss(netemLoss)
+		if err != nil {
+			return err
+		}
+		profile := entities.NetemProfile{
+			Delay:  netemDelay,
+			Jitter: netemJitter,
+			Loss:   loss,
+			Rate:   strings.ToLower(netemRate),
+		}
+		return handler.HandleNetem(ctx, args, profile, netemDuration)
+	},
+}
+
+func init() {
+	netemCmd.Flags().DurationVar(&netemDelay, "delay", 0, "Latency added to each packet (e.g. 100ms)")
+	netemCmd.Flags().DurationVar(&netemJitter, "jitter", 0, "Random variation of the delay (e.g. 20ms)")
+	netemCmd.Flags().StringVar(&netemLoss, "loss", "", "Percentage of dropped packets (e.g. 2%)")
+	netemCmd.Flags().StringVar(&netemRate, "rate", "", "Bandwidth limit in tc units (e.g. 1mbit, 512kbit)")
+	netemCmd.Flags().DurationVar(&netemDuration, "duration", 0, "How long the impairment lasts (default: until Ctrl+C)")
+	netemCmd.Flags().BoolVar(&netemClear, "clear", false, "Only remove the impairment left by an interrupted run")
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Flags des commandes keys
+	keysForce      bool
+	keysOutput     string
+	keysPrivateKey bool
+	keysMnemonic   string
+	keysAccounts   int
+)
+
+// keysCmd représente le groupe de commandes keys
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Manage the encrypted keystores of node accounts",
+	Long: `Keys are stored as V3 keystores (Web3 Secret Storage), encrypted with a passphrase
+read from BENCHY_KEYSTORE_PASSWORD or asked at the prompt:
+
+benchy keys list                              Accounts, addresses and key format
+benchy keys import alice ./alice.json         Import a V3 keystore for a node
+benchy keys import deployer 0x4c0883a6…       Import a hex private key as an extra account
+benchy keys export bob --out bob.json         Keystore for MetaMask, cast --keystore or geth --unlock
+benchy keys export bob --private-key          Print the raw private key (asks for confirmation)
+benchy keys mnemonic                          New mnemonic and the addresses it gives the nodes`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return keysListCmd.RunE(cmd, args)
+	},
+}
+
+// keysListCmd représente la commande keys list
+var keysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show the stored accounts and how their keys are stored",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleKeysList(context.Background())
+	},
+}
+
+// keysImportCmd représente la commande keys import
+var keysImportCmd = &cobra.Command{
+	Use:   "import [name] [keystore file|key file|hex key]",
+	Short: "Import a key and store it as an encrypted keystore",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleKeysImport(context.Background(), args[0], args[1], keysForce)
+	},
+}
+
+// keysExportCmd représente la commande keys export
+var keysExportCmd = &cobra.Command{
+	Use:   "export [name]",
+	Short: "Export an account's V3 keystore or private key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleKeysExport(context.Background(), args[0], keysOutput, keysPrivateKey)
+	},
+}
+
+// keysMnemonicCmd représente la commande keys mnemonic
+var keysMnemonicCmd = &cobra.Command{
+	Use:   "mnemonic",
+	Short: "Generate a mnemonic, or preview the accounts one derives",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleKeysMnemonic(context.Background(), keysMnemonic, keysAccounts)
+	},
+}
+
+func init() {
+	keysImportCmd.Flags().BoolVar(&keysForce, "force", false, "Replace an existing key")
+	keysExportCmd.Flags().StringVarP(&keysOutput, "out", "o", "", "Write the keystore to a file instead of stdout")
+	keysExportCmd.Flags().BoolVar(&keysPrivateKey, "private-key", false, "Print the raw private key in hex")
+
+	keysMnemonicCmd.Flags().StringVar(&keysMnemonic, "mnemonic", "", "Mnemonic to preview (default: generate a new one)")
+	keysMnemonicCmd.Flags().IntVar(&keysAccounts, "accounts", 0, "Extra test accounts to derive after the nodes")
+
+	keysCmd.AddCommand(keysListCmd)
+	keysCmd.AddCommand(keysImportCmd)
+	keysCmd.AddCommand(keysExportCmd)
+	keysCmd.AddCommand(keysMnemonicCmd)
+}
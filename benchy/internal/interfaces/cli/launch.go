@@ -6,6 +6,7 @@ import (
 
 	"benchy/internal/application/handlers"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // launchCmd représente la commande launch-network
@@ -16,7 +17,22 @@ var launchCmd = &cobra.Command{
 - Alice, Bob, Cassandra (validators)
 - Driss, Elena (normal nodes)
 - Mix of Geth and Nethermind clients
-- Clique consensus algorithm`,
+- Clique consensus algorithm
+
+Nodes run with --nodiscover; once launched they are peered through
+admin_addPeer following --topology:
+  mesh                  every node connected to every other (default)
+  ring                  alice-bob-cassandra-driss-elena-alice
+  star, star:<node>     every node connected to a hub (default: alice)
+  alice-bob,bob-driss   explicit edge list
+  none                  no automatic peering
+
+Node keys are kept between launches. With --mnemonic (or "mnemonic" in
+.benchy.yaml, or BENCHY_MNEMONIC) they are derived along m/44'/60'/0'/0/i:
+alice is index 0 … elena index 4, and --accounts N adds funded test
+accounts at index 5 and up. The same mnemonic always gives the same
+addresses and genesis allocations. If stored keys differ from the ones
+the mnemonic derives, launch asks before replacing them.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Créer le handler
 		handler, err := handlers.NewCLIHandler()
@@ -28,6 +44,48 @@ var launchCmd = &cobra.Command{
 		ctx := context.Background()
 
 		// Exécuter le lancement du réseau
-		return handler.HandleLaunchNetwork(ctx)
+		mnemonic, accounts := launchAccounts(cmd)
+		return handler.HandleLaunchNetwork(ctx, launchTopology, mnemonic, accounts)
 	},
 }
+
+// launchTopology est la topologie de peering appliquée après le lancement
+var launchTopology string
+
+// launchTopologyUsage décrit le flag --topology, partagé avec peers connect
+const launchTopologyUsage = "Peering topology: mesh, ring, star, star:<node>, none or edges (alice-bob,bob-driss)"
+
+var (
+	// Flags de dérivation des comptes, partagés avec docker launch-real
+	launchMnemonic      string
+	launchExtraAccounts int
+)
+
+// addLaunchAccountFlags ajoute --mnemonic et --accounts à une commande de lancement
+func addLaunchAccountFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&launchMnemonic, "mnemonic", "", "BIP-39 mnemonic the node keys are derived from")
+	cmd.Flags().IntVar(&launchExtraAccounts, "accounts", 0, "Extra funded test accounts derived after the nodes")
+}
+
+// launchAccounts retourne la mnémonique et le nombre de comptes : flags, sinon .benchy.yaml ou environnement
+func launchAccounts(cmd *cobra.Command) (string, int) {
+	mnemonic := launchMnemonic
+	if !cmd.Flags().Changed("mnemonic") {
+		mnemonic = viper.GetString("mnemonic")
+	}
+
+	accounts := launchExtraAccounts
+	if !cmd.Flags().Changed("accounts") {
+		accounts = viper.GetInt("accounts")
+	}
+
+	return mnemonic, accounts
+}
+
+func init() {
+	launchCmd.Flags().StringVar(&launchTopology, "topology", "mesh", launchTopologyUsage)
+	addLaunchAccountFlags(launchCmd)
+
+	viper.BindEnv("mnemonic", "BENCHY_MNEMONIC")
+	viper.BindEnv("accounts", "BENCHY_ACCOUNTS")
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"benchy/internal/application/services"
+	"github.com/spf13/cobra"
+)
+
+// logsRequest regroupe les flags de la commande logs
+var logsRequest services.LogsRequest
+
+// logsCmd représente la commande logs
+var logsCmd = &cobra.Command{
+	Use:   "logs [node...]",
+	Short: "Show node logs, interleaved by timestamp",
+	Long: `Show the logs of one or more nodes (all of them by default), interleaved by
+timestamp with a coloured node prefix:
+
+benchy logs                          Last 100 lines of every node
+benchy logs alice bob -f             Follow Alice and Bob
+benchy logs --since 10m --level warn Warnings and errors of the whole network
+benchy logs cassandra --grep "(?i)peer"
+
+--level keeps the lines at or above a level (trace, debug, info, warn, error,
+crit), recognised in both Geth and Nethermind formats. Lines without a level,
+such as stack traces, take the level of the line they follow.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		// --since sans --tail : toutes les lignes depuis cette date
+		if logsRequest.Since != "" && !cmd.Flags().Changed("tail") {
+			logsRequest.Tail = "all"
+		}
+		logsRequest.Nodes = args
+
+		return handler.HandleLogs(context.Background(), logsRequest)
+	},
+}
+
+func init() {
+	logsCmd.Flags().BoolVarP(&logsRequest.Follow, "follow", "f", false, "Follow the logs as the nodes write them")
+	logsCmd.Flags().StringVar(&logsRequest.Since, "since", "", "Show logs since a duration (10m) or a date (2024-10-16T12:00:00)")
+	logsCmd.Flags().StringVarP(&logsRequest.Tail, "tail", "n", "100", "Lines per node before following (\"all\" for every line)")
+	logsCmd.Flags().StringVar(&logsRequest.Grep, "grep", "", "Only show lines matching this regular expression")
+	logsCmd.Flags().StringVar(&logsRequest.Level, "level", "", "Only show lines at or above this level (e.g. warn)")
+}
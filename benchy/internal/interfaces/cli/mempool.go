@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+// mempoolCmd représente la commande mempool
+var mempoolCmd = &cobra.Command{
+	Use:   "mempool [node]",
+	Short: "Inspect pending and queued transactions",
+	Long: `Inspect the transaction pool of the nodes:
+
+benchy mempool               Pending and queued counts of every node
+benchy mempool cassandra     Transactions per sender with nonce, fees and age
+benchy mempool driss -u 2    Refresh every 2 seconds
+
+Queued transactions wait for a missing nonce; the missing nonces are reported
+below the table.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		node := ""
+		if len(args) == 1 {
+			node = args[0]
+		}
+
+		return handler.HandleMempool(context.Background(), node, updateInterval)
+	},
+}
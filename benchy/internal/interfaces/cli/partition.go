@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// partitionDuration est la durée de la séparation avant la réunification
+	partitionDuration time.Duration
+	// partitionHeal retire seulement les règles d'une partition interrompue
+	partitionHeal bool
+)
+
+// partitionCmd représente la commande partition
+var partitionCmd = &cobra.Command{
+	Use:   "partition <groupA> [groupB]",
+	Short: "Split the network between two groups of nodes, then heal it",
+	Long: `Split the Docker network between two groups of nodes for --duration, then heal it:
+
+benchy partition alice,bob cassandra,driss,elena
+benchy partition "alice,bob | cassandra,driss,elena" --duration 2m
+benchy partition alice,bob             The other nodes form the second group
+benchy partition --heal                Remove the rules of an interrupted partition
+
+Each node drops the traffic of the opposite group with iptables, set from a
+helper container that shares its network namespace; JSON-RPC from the host
+keeps working. Nodes listed in neither group still reach both sides.
+
+While the network is split, benchy prints the head of each group and how many
+blocks each side sealed since their last common block. Once healed, it waits
+for the nodes to agree and prints how many blocks each node had to replace
+(reorg depth). Ctrl+C heals the network immediately.`,
+	Args: cobra.RangeArgs(0, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		// Ctrl+C interrompt la partition, qui réunifie alors le réseau avant de rendre la main
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if partitionHeal {
+			if len(args) > 0 {
+				return fmt.Errorf("--heal takes no groups")
+			}
+			return handler.HandlePartitionHeal(ctx)
+		}
+		if len(args) == 0 {
+			return fmt.Errorf("missing groups: benchy partition <groupA> [groupB]")
+		}
+
+		groupB := ""
+		if len(args) == 2 {
+			groupB = args[1]
+		}
+		return handler.HandlePartition(ctx, args[0], groupB, partitionDuration)
+	},
+}
+
+func init() {
+	partitionCmd.Flags().DurationVar(&partitionDuration, "duration", time.Minute, "How long the network stays split")
+	partitionCmd.Flags().BoolVar(&partitionHeal, "heal", false, "Only remove the partition rules left by an interrupted run")
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+// peersTopology est la topologie demandée à peers connect
+var peersTopology string
+
+// peersCmd représente le groupe de commandes peers
+var peersCmd = &cobra.Command{
+	Use:   "peers",
+	Short: "Inspect and wire P2P connections between nodes",
+	Long: `Nodes run with --nodiscover and only know the peers benchy gives them:
+
+benchy peers list                          Enode and peer count of each node
+benchy peers connect                       Peer every node with every other
+benchy peers connect --topology ring       Peer the nodes in a ring
+benchy peers connect --topology alice-bob,bob-elena
+
+Enodes are rewritten with each container's IP on benchy-network.
+Peers added through admin_addPeer are lost when a node restarts:
+run 'benchy peers connect' again afterwards.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return peersListCmd.RunE(cmd, args)
+	},
+}
+
+// peersListCmd représente la commande peers list
+var peersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show each node's enode and peer count",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandlePeersList(context.Background())
+	},
+}
+
+// peersConnectCmd représente la commande peers connect
+var peersConnectCmd = &cobra.Command{
+	Use:   "connect",
+	Short: "Connect the nodes following a topology",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandlePeersConnect(context.Background(), peersTopology)
+	},
+}
+
+func init() {
+	peersConnectCmd.Flags().StringVar(&peersTopology, "topology", "mesh", launchTopologyUsage)
+
+	peersCmd.AddCommand(peersListCmd)
+	peersCmd.AddCommand(peersConnectCmd)
+}
@@ -41,9 +41,21 @@ func init() {
 
 	// Ajouter toutes les sous-commandes
 	rootCmd.AddCommand(launchCmd)
+	rootCmd.AddCommand(teardownCmd)
 	rootCmd.AddCommand(infosCmd)
 	rootCmd.AddCommand(scenarioCmd)
 	rootCmd.AddCommand(failureCmd)
+	rootCmd.AddCommand(netemCmd)
+	rootCmd.AddCommand(partitionCmd)
+	rootCmd.AddCommand(validatorsCmd)
+	rootCmd.AddCommand(peersCmd)
+	rootCmd.AddCommand(mempoolCmd)
+	rootCmd.AddCommand(logsCmd)
+	rootCmd.AddCommand(blockCmd)
+	rootCmd.AddCommand(txCmd)
+	rootCmd.AddCommand(accountCmd)
+	rootCmd.AddCommand(contractCmd)
+	rootCmd.AddCommand(keysCmd)
 }
 
 // initConfig lit la configuration depuis un fichier config et les variables d'environnement
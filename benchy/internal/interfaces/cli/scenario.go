@@ -3,7 +3,10 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 
 	"benchy/internal/application/handlers"
 	"github.com/spf13/cobra"
@@ -18,7 +21,14 @@ var scenarioCmd = &cobra.Command{
 Scenario 0 (init):        Initialize network with ETH for validators
 Scenario 1 (transfers):   Alice sends 0.1 ETH to Bob every 10 seconds  
 Scenario 2 (erc20):       Deploy ERC20 token and distribute to Driss/Elena
-Scenario 3 (replacement): Test transaction replacement with higher fee`,
+Scenario 3 (replacement): Test transaction replacement with higher fee
+
+With --backend=sim, the scenario runs offline on an in-process chain built from
+the network genesis (same accounts and balances), without nodes or Docker.
+
+With --netem, the links of every node are degraded with tc netem while the
+scenario runs (e.g. --netem delay=100ms,jitter=20ms,loss=2%,rate=1mbit), then
+restored, including on Ctrl+C.`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		scenario := args[0]
@@ -47,10 +57,29 @@ Scenario 3 (replacement): Test transaction replacement with higher fee`,
 			return fmt.Errorf("failed to initialize handler: %w", err)
 		}
 
-		// Créer le contexte
-		ctx := context.Background()
+		// Créer le contexte : Ctrl+C interrompt le scénario et retire la dégradation --netem
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
 
 		// Exécuter le scénario
-		return handler.HandleScenario(ctx, args[0])
+		return handler.HandleScenario(ctx, args[0], scenarioFeePolicy, scenarioBackend, scenarioNetem)
 	},
 }
+
+var (
+	// scenarioFeePolicy est la politique de frais EIP-1559 des transactions du scénario
+	scenarioFeePolicy string
+	// scenarioBackend choisit la chaîne du scénario : réseau Docker (rpc) ou chaîne en mémoire (sim)
+	scenarioBackend string
+	// scenarioNetem dégrade les liens des nodes pendant le scénario (ex: delay=100ms,loss=2%)
+	scenarioNetem string
+)
+
+func init() {
+	scenarioCmd.Flags().StringVar(&scenarioFeePolicy, "fee-policy", "normal",
+		"EIP-1559 fee policy: normal, fast or a tip multiplier (e.g. 1.5)")
+	scenarioCmd.Flags().StringVar(&scenarioBackend, "backend", "rpc",
+		"Chain backend: rpc (nodes of the Docker network) or sim (offline in-process chain)")
+	scenarioCmd.Flags().StringVar(&scenarioNetem, "netem", "",
+		"Degrade node links during the scenario: delay=100ms,jitter=20ms,loss=2%,rate=1mbit")
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+// teardownVolumes supprime aussi les volumes de données des nodes
+var teardownVolumes bool
+
+// teardownCmd représente la commande teardown
+var teardownCmd = &cobra.Command{
+	Use:   "teardown",
+	Short: "Remove the network's containers and Docker network",
+	Long: `Remove every Docker resource created by launch-network: node containers,
+interrupted genesis init or network helper containers and the benchy-network
+network.
+
+Resources are found by their benchy.network label, never by name: a container
+called benchy-something that benchy did not create is left alone. Node data
+volumes are kept unless --volumes is given; keystores and genesis.json in
+~/.benchy are never removed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleTeardown(context.Background(), teardownVolumes)
+	},
+}
+
+func init() {
+	teardownCmd.Flags().BoolVar(&teardownVolumes, "volumes", false, "Also remove the node data volumes")
+}
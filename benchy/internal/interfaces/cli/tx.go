@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"benchy/internal/application/services"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Flags des commandes tx build, sign et broadcast
+	rawTx       services.RawTxRequest
+	rawTxOutput string
+	rawTxNode   string
+)
+
+// txBuildCmd représente la commande tx build
+var txBuildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build an unsigned transaction field by field",
+	Long: `Build an unsigned transaction and print its RLP hex and signing hash.
+Missing fields (nonce, gas, fees, chain ID) are filled from --node, unless --offline:
+
+benchy tx build --from alice --to bob --value 1
+benchy tx build --from bob --to Counter --data 0x3fb5c1cb…2a --gas 50000
+benchy tx build --type legacy --gas-price 2 --nonce 7 --gas 21000 --chain-id 1337 --to driss --offline
+
+Without --to, the transaction deploys the contract in --data.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleTxBuild(context.Background(), rawTx, rawTxOutput)
+	},
+}
+
+// txSignCmd représente la commande tx sign
+var txSignCmd = &cobra.Command{
+	Use:   "sign [unsigned rlp|file]",
+	Short: "Sign a transaction with a node or imported key",
+	Long: `Sign an unsigned transaction from 'benchy tx build' with a benchy key (node or
+imported account) and print the signed RLP hex and transaction hash:
+
+benchy tx sign 0x02f0… --from alice
+benchy tx sign unsigned.txt --from account1 --out signed.txt
+
+Without a transaction, build it from the same flags as 'tx build' and sign it.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		input := ""
+		if len(args) == 1 {
+			input = args[0]
+		}
+
+		return handler.HandleTxSign(context.Background(), input, rawTx, rawTxOutput)
+	},
+}
+
+// txBroadcastCmd représente la commande tx broadcast
+var txBroadcastCmd = &cobra.Command{
+	Use:   "broadcast [signed rlp|file]",
+	Short: "Broadcast a signed transaction to a node",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleTxBroadcast(context.Background(), rawTxNode, args[0])
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{txBuildCmd, txSignCmd} {
+		cmd.Flags().StringVar(&rawTx.From, "from", "alice", "Sender: node or imported account (its key signs with tx sign)")
+		cmd.Flags().StringVar(&rawTx.To, "to", "", "Recipient: node, account, contract name or address (empty: contract creation)")
+		cmd.Flags().StringVar(&rawTx.Value, "value", "0", "ETH sent")
+		cmd.Flags().StringVar(&rawTx.Data, "data", "", "Calldata or deployment bytecode in 0x hex")
+		cmd.Flags().StringVar(&rawTx.Nonce, "nonce", "", "Nonce (default: pending nonce of --from)")
+		cmd.Flags().StringVar(&rawTx.Gas, "gas", "", "Gas limit (default: estimated)")
+		cmd.Flags().StringVar(&rawTx.GasPrice, "gas-price", "", "Gas price in gwei (legacy)")
+		cmd.Flags().StringVar(&rawTx.GasTipCap, "tip", "", "Max priority fee in gwei (EIP-1559)")
+		cmd.Flags().StringVar(&rawTx.GasFeeCap, "fee-cap", "", "Max fee per gas in gwei (EIP-1559)")
+		cmd.Flags().StringVar(&rawTx.Type, "type", "1559", "Transaction type: 1559 (2) or legacy (0)")
+		cmd.Flags().StringVar(&rawTx.ChainID, "chain-id", "", "Chain ID (default: chain of --node)")
+		cmd.Flags().StringVar(&rawTx.Node, "node", "alice", "Node used to fill the missing fields")
+		cmd.Flags().BoolVar(&rawTx.Offline, "offline", false, "Do not query a node: nonce, gas, fees and chain ID are required")
+		cmd.Flags().StringVar(&rawTxOutput, "out", "", "Also write the RLP hex to a file")
+	}
+
+	txBroadcastCmd.Flags().StringVar(&rawTxNode, "node", "alice", "Node to broadcast to")
+
+	txCmd.AddCommand(txBuildCmd)
+	txCmd.AddCommand(txSignCmd)
+	txCmd.AddCommand(txBroadcastCmd)
+}
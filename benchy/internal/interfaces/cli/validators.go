@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"benchy/internal/application/handlers"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Flags des commandes validators
+	validatorsRemove bool
+	validatorsVoters []string
+)
+
+// validatorsCmd représente le groupe de commandes validators
+var validatorsCmd = &cobra.Command{
+	Use:   "validators",
+	Short: "Manage Clique validators",
+	Long: `Inspect and change the Clique signer set:
+
+benchy validators list                     Current signers and pending votes
+benchy validators propose driss            Current signers vote to add Driss
+benchy validators propose cassandra --remove
+                                           Current signers vote to remove Cassandra
+benchy validators discard driss            Withdraw the pending votes for Driss
+benchy validators watch                    Follow the signer set block by block
+
+A change takes effect once a majority of the current signers has voted.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return validatorsListCmd.RunE(cmd, args)
+	},
+}
+
+// validatorsListCmd représente la commande validators list
+var validatorsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show current signers and pending votes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleValidatorsList(context.Background())
+	},
+}
+
+// validatorsProposeCmd représente la commande validators propose
+var validatorsProposeCmd = &cobra.Command{
+	Use:   "propose [node|address]",
+	Short: "Vote to add (or --remove) a validator",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleValidatorsPropose(context.Background(), args[0], !validatorsRemove, validatorsVoters)
+	},
+}
+
+// validatorsDiscardCmd représente la commande validators discard
+var validatorsDiscardCmd = &cobra.Command{
+	Use:   "discard [node|address]",
+	Short: "Withdraw pending votes for a validator",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleValidatorsDiscard(context.Background(), args[0], validatorsVoters)
+	},
+}
+
+// validatorsWatchCmd représente la commande validators watch
+var validatorsWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Follow the signer set block by block",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		handler, err := handlers.NewCLIHandler()
+		if err != nil {
+			return fmt.Errorf("failed to initialize handler: %w", err)
+		}
+
+		return handler.HandleValidatorsWatch(context.Background())
+	},
+}
+
+func init() {
+	validatorsProposeCmd.Flags().BoolVar(&validatorsRemove, "remove", false, "Vote to remove the validator instead of adding it")
+
+	for _, cmd := range []*cobra.Command{validatorsProposeCmd, validatorsDiscardCmd} {
+		cmd.Flags().StringSliceVar(&validatorsVoters, "voters", nil, "Nodes casting the vote (default: all current signers)")
+	}
+
+	validatorsCmd.AddCommand(validatorsListCmd)
+	validatorsCmd.AddCommand(validatorsProposeCmd)
+	validatorsCmd.AddCommand(validatorsDiscardCmd)
+	validatorsCmd.AddCommand(validatorsWatchCmd)
+}
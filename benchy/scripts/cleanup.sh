@@ -1,13 +1,15 @@
 #!/bin/bash
 echo "🧹 Cleaning up existing benchy containers..."
 
-# Arrêter tous les containers benchy
-docker ps | grep benchy | awk '{print $1}' | xargs -r docker stop
+# Les ressources benchy sont reconnues à leur label, pas à leur nom
+LABEL="label=benchy.network=benchy-network"
 
-# Supprimer tous les containers benchy
-docker ps -a | grep benchy | awk '{print $1}' | xargs -r docker rm
+# Supprimer tous les containers benchy (arrêtés compris)
+docker ps -aq --filter "$LABEL" | xargs -r docker rm -f
 
 # Supprimer le réseau benchy
-docker network rm benchy-network 2>/dev/null || true
+docker network ls -q --filter "$LABEL" | xargs -r docker network rm
+
+# Les volumes de données des nodes sont conservés : docker volume ls --filter "$LABEL"
 
 echo "✅ Cleanup completed"